@@ -47,6 +47,10 @@ func eventTypeToStorageEventType(eventType *storagepb.BlobEventType) []string {
 		return []string{"s3:ObjectCreated:*"}
 	case storagepb.BlobEventType_Deleted:
 		return []string{"s3:ObjectRemoved:*"}
+	case storagepb.BlobEventType_MetadataUpdated:
+		return []string{"s3:ObjectTagging:*"}
+	case storagepb.BlobEventType_Restored:
+		return []string{"s3:ObjectRestore:Completed"}
 	default:
 		return []string{}
 	}
@@ -90,6 +94,7 @@ func createNotification(ctx *pulumi.Context, name string, args *S3NotificationAr
 				eventTypeToStorageEventType(&listener.Config.BlobEventType),
 			),
 			FilterPrefix: pulumi.String(listener.Config.KeyPrefixFilter),
+			FilterSuffix: pulumi.String(listener.Config.KeySuffixFilter),
 		}.ToBucketNotificationLambdaFunctionOutput())
 	}
 
@@ -56,6 +56,11 @@ func (n *NitricAwsPulumiProvider) KeyValueStore(ctx *pulumi.Context, parent pulu
 		RangeKey:    pulumi.String("_sk"),
 		BillingMode: pulumi.String("PAY_PER_REQUEST"),
 		Tags:        pulumi.ToStringMap(tags.Tags(n.StackId, name, resources.Collection)),
+		// values set with a ttl are removed by DynamoDB once their _expiry time passes
+		Ttl: &dynamodb.TableTtlArgs{
+			AttributeName: pulumi.String("_expiry"),
+			Enabled:       pulumi.Bool(true),
+		},
 	}, opts...)
 
 	return err
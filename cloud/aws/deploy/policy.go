@@ -64,9 +64,11 @@ var awsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_KeyValueStoreWrite: {
 		"dynamodb:UpdateItem",
 		"dynamodb:PutItem",
+		"dynamodb:BatchWriteItem",
 	},
 	resourcespb.Action_KeyValueStoreDelete: {
 		"dynamodb:DeleteItem",
+		"dynamodb:BatchWriteItem",
 	},
 	resourcespb.Action_SecretAccess: {
 		"secretsmanager:GetSecretValue",
@@ -86,6 +88,7 @@ var awsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_QueueDequeue: {
 		"sqs:ReceiveMessage",
 		"sqs:DeleteMessage",
+		"sqs:ChangeMessageVisibility",
 		"sqs:GetQueueAttributes",
 		"sqs:GetQueueUrl",
 		"sqs:ListQueueTags",
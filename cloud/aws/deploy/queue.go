@@ -17,6 +17,9 @@
 package deploy
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	common "github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
@@ -24,13 +27,27 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// maxReceiveCount is the largest number of receives SQS allows before moving a message to a dead letter queue
+const maxReceiveCount = 1000
+
 // Queue - Implements deployments of Nitric Queues using AWS SQS
 func (a *NitricAwsPulumiProvider) Queue(ctx *pulumi.Context, parent pulumi.Resource, name string, config *deploymentspb.Queue) error {
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
 
-	queue, err := sqs.NewQueue(ctx, name, &sqs.QueueArgs{
+	queueArgs := &sqs.QueueArgs{
 		Tags: pulumi.ToStringMap(common.Tags(a.StackId, name, resources.Queue)),
-	}, opts...)
+	}
+
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		redrivePolicy, err := a.redrivePolicy(name, deadLetter)
+		if err != nil {
+			return err
+		}
+
+		queueArgs.RedrivePolicy = redrivePolicy
+	}
+
+	queue, err := sqs.NewQueue(ctx, name, queueArgs, opts...)
 	if err != nil {
 		return err
 	}
@@ -39,3 +56,24 @@ func (a *NitricAwsPulumiProvider) Queue(ctx *pulumi.Context, parent pulumi.Resou
 
 	return nil
 }
+
+// redrivePolicy - Returns an SQS redrive policy moving messages to a previously deployed dead letter queue
+func (a *NitricAwsPulumiProvider) redrivePolicy(name string, deadLetter *deploymentspb.QueueDeadLetter) (pulumi.StringOutput, error) {
+	if deadLetter.MaxReceives < 1 || deadLetter.MaxReceives > maxReceiveCount {
+		return pulumi.StringOutput{}, fmt.Errorf("queue %s max receives must be between 1 and %d, got %d", name, maxReceiveCount, deadLetter.MaxReceives)
+	}
+
+	deadLetterQueue, ok := a.Queues[deadLetter.QueueName]
+	if !ok {
+		return pulumi.StringOutput{}, fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+	}
+
+	return deadLetterQueue.Arn.ApplyT(func(arn string) (string, error) {
+		policy, err := json.Marshal(map[string]interface{}{
+			"deadLetterTargetArn": arn,
+			"maxReceiveCount":     deadLetter.MaxReceives,
+		})
+
+		return string(policy), err
+	}).(pulumi.StringOutput), nil
+}
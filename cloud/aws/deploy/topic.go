@@ -143,8 +143,9 @@ func (a *NitricAwsPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 					"Type":     "Task",
 					"Resource": "arn:aws:states:::sns:publish",
 					"Parameters": map[string]string{
-						"TopicArn":  arn,
-						"Message.$": "$.message",
+						"TopicArn":            arn,
+						"Message.$":           "$.message",
+						"MessageAttributes.$": "$.attributes",
 					},
 					"End": true,
 				},
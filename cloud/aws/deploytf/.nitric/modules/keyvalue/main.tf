@@ -13,6 +13,10 @@ resource "aws_dynamodb_table" "table" {
   hash_key  = "_pk"
   range_key = "_sk"
   billing_mode = "PAY_PER_REQUEST"
+  ttl {
+    attribute_name = "_expiry"
+    enabled        = true
+  }
   tags = {
     "x-nitric-${var.stack_id}-name" = var.kvstore_name
     "x-nitric-${var.stack_id}-type" = "kvstore"
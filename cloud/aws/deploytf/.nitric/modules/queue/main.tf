@@ -2,6 +2,10 @@
 # Deploy an SQS queue
 resource "aws_sqs_queue" "queue" {
   name = var.queue_name
+  redrive_policy = var.max_receive_count == null ? null : jsonencode({
+    deadLetterTargetArn = var.dead_letter_queue_arn
+    maxReceiveCount     = var.max_receive_count
+  })
   tags = {
     "x-nitric-${var.stack_id}-name" = var.queue_name
     "x-nitric-${var.stack_id}-type" = "queue"
@@ -7,3 +7,15 @@ variable "stack_id" {
   description = "The ID of the Nitric stack"
   type        = string
 }
+
+variable "dead_letter_queue_arn" {
+  description = "The ARN of the queue that receives messages received more than max_receive_count times"
+  type        = string
+  default     = null
+}
+
+variable "max_receive_count" {
+  description = "The number of times a message can be received before it's moved to the dead letter queue, messages are never dead lettered if null"
+  type        = number
+  default     = null
+}
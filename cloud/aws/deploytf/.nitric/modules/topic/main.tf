@@ -91,6 +91,7 @@ resource "aws_sfn_state_machine" "publish_to_topic" {
         Parameters = {
           TopicArn = aws_sns_topic.topic.arn,
           "Message.$" : "$.message",
+          "MessageAttributes.$" : "$.attributes",
         },
         End = true
       }
@@ -32,6 +32,14 @@ func eventsForBlobEventType(blobEventType storagepb.BlobEventType) []string {
 		return []string{
 			"s3:ObjectRemoved:*",
 		}
+	case storagepb.BlobEventType_MetadataUpdated:
+		return []string{
+			"s3:ObjectTagging:*",
+		}
+	case storagepb.BlobEventType_Restored:
+		return []string{
+			"s3:ObjectRestore:Completed",
+		}
 	default:
 		return []string{}
 	}
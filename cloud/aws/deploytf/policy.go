@@ -52,9 +52,11 @@ var AwsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_KeyValueStoreWrite: {
 		"dynamodb:UpdateItem",
 		"dynamodb:PutItem",
+		"dynamodb:BatchWriteItem",
 	},
 	resourcespb.Action_KeyValueStoreDelete: {
 		"dynamodb:DeleteItem",
+		"dynamodb:BatchWriteItem",
 	},
 	resourcespb.Action_SecretAccess: {
 		"secretsmanager:GetSecretValue",
@@ -74,6 +76,7 @@ var AwsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_QueueDequeue: {
 		"sqs:ReceiveMessage",
 		"sqs:DeleteMessage",
+		"sqs:ChangeMessageVisibility",
 		"sqs:GetQueueAttributes",
 		"sqs:GetQueueUrl",
 		"sqs:ListQueueTags",
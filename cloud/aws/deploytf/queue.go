@@ -15,18 +15,40 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/aws/deploytf/generated/queue"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 )
 
+// maxReceiveCount is the largest number of receives SQS allows before moving a message to a dead letter queue
+const maxReceiveCount = 1000
+
 // // Queue - Deploy a Queue
 func (a *NitricAwsTerraformProvider) Queue(stack cdktf.TerraformStack, name string, config *deploymentspb.Queue) error {
-	a.Queues[name] = queue.NewQueue(stack, jsii.Sprintf("queue_%s", name), &queue.QueueConfig{
+	q := queue.NewQueue(stack, jsii.Sprintf("queue_%s", name), &queue.QueueConfig{
 		QueueName: jsii.String(name),
 		StackId:   a.Stack.StackIdOutput(),
 	})
 
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		if deadLetter.MaxReceives < 1 || deadLetter.MaxReceives > maxReceiveCount {
+			return fmt.Errorf("queue %s max receives must be between 1 and %d, got %d", name, maxReceiveCount, deadLetter.MaxReceives)
+		}
+
+		deadLetterQueue, ok := a.Queues[deadLetter.QueueName]
+		if !ok {
+			return fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+		}
+
+		// the dead letter inputs are optional module variables, so they're set directly on the module
+		q.AddOverride(jsii.String("dead_letter_queue_arn"), deadLetterQueue.QueueArnOutput())
+		q.AddOverride(jsii.String("max_receive_count"), jsii.Number(deadLetter.MaxReceives))
+	}
+
+	a.Queues[name] = q
+
 	return nil
 }
@@ -54,3 +54,23 @@ func (mr *MockSNSAPIMockRecorder) Publish(arg0, arg1 interface{}, arg2 ...interf
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockSNSAPI)(nil).Publish), varargs...)
 }
+
+// PublishBatch mocks base method.
+func (m *MockSNSAPI) PublishBatch(arg0 context.Context, arg1 *sns.PublishBatchInput, arg2 ...func(*sns.Options)) (*sns.PublishBatchOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishBatch", varargs...)
+	ret0, _ := ret[0].(*sns.PublishBatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishBatch indicates an expected call of PublishBatch.
+func (mr *MockSNSAPIMockRecorder) PublishBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBatch", reflect.TypeOf((*MockSNSAPI)(nil).PublishBatch), varargs...)
+}
@@ -40,9 +40,14 @@ func (a *AwsApiGatewayProvider) ApiDetails(ctx context.Context, req *apispb.ApiD
 		return nil, err
 	}
 
-	return &apispb.ApiDetailsResponse{
-		Url: gwDetails.Url,
-	}, nil
+	details, err := a.RouteWorkerManager.ApiDetails(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	details.Url = gwDetails.Url
+
+	return details, nil
 }
 
 func NewAwsApiGatewayProvider(resolver resource.AwsResourceResolver) *AwsApiGatewayProvider {
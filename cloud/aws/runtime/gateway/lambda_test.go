@@ -16,6 +16,7 @@ package gateway_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -137,7 +138,7 @@ var _ = Describe("Lambda", func() {
 				// mockHandler.EXPECT().HandlesTrigger(gomock.Any()).Return(true)
 
 				By("Handling a single HTTP request")
-				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&apispb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), gomock.Any(), EqProto(&apispb.ServerMessage{
 					Content: &apispb.ServerMessage_HttpRequest{
 						HttpRequest: &apispb.HttpRequest{
 							Method: "GET",
@@ -217,7 +218,7 @@ var _ = Describe("Lambda", func() {
 				}, nil)
 
 				By("Handling a single HTTP request")
-				mockManager.EXPECT().HandleRequest(&websocketspb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &websocketspb.ServerMessage{
 					Content: &websocketspb.ServerMessage_WebsocketEventRequest{
 						WebsocketEventRequest: &websocketspb.WebsocketEventRequest{
 							SocketName:   "test-api",
@@ -304,7 +305,7 @@ var _ = Describe("Lambda", func() {
 				mockManager.EXPECT().WorkerCount().Return(1)
 
 				By("Handling a single event")
-				mockManager.EXPECT().HandleRequest(EqProto(&topicspb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&topicspb.ServerMessage{
 					Content: &topicspb.ServerMessage_MessageRequest{
 						MessageRequest: &topicspb.MessageRequest{
 							TopicName: "MyTopic",
@@ -330,6 +331,80 @@ var _ = Describe("Lambda", func() {
 		})
 	})
 
+	Context("SNS Events with attributes", func() {
+		When("The Lambda Gateway receives SNS events with message attributes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockResolver := mock_provider.NewMockAwsResourceResolver(ctrl)
+			mockManager := mock_topics.NewMockSubscriptionRequestHandler(ctrl)
+
+			content, _ := structpb.NewStruct(map[string]interface{}{
+				"test": "test",
+			})
+
+			message := topicspb.TopicMessage{
+				Content: &topicspb.TopicMessage_StructPayload{
+					StructPayload: content,
+				},
+			}
+
+			messageBytes, err := proto.Marshal(&message)
+			Expect(err).To(BeNil())
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.SNSEvent{
+					Records: []events.SNSEventRecord{
+						{
+							EventSource: "aws:sns",
+							SNS: events.SNSEntity{
+								TopicArn: "arn:aws:sns:us-east-1:12345678910:arn:MyTopic",
+								Message:  base64.StdEncoding.EncodeToString(messageBytes),
+								MessageAttributes: map[string]interface{}{
+									"region":          map[string]interface{}{"Type": "String", "Value": "ap-southeast-2"},
+									"X-Amzn-Trace-Id": map[string]interface{}{"Type": "String", "Value": "Root=1-5759e988-bd862e3fe1be46a994272793"},
+								},
+							},
+						},
+					},
+				}},
+			}
+
+			client := gateway.New(mockResolver, gateway.WithRuntime(runtime.Start))
+
+			It("The gateway should pass the attributes to subscribers without the trace context", func() {
+				defer ctrl.Finish()
+
+				By("having the topic available")
+				mockResolver.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"MyTopic": {
+						ARN: "arn:aws:sns:us-east-1:12345678910:arn:MyTopic",
+					},
+				}, nil)
+
+				By("Handling the event with its attributes")
+				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&topicspb.ServerMessage{
+					Content: &topicspb.ServerMessage_MessageRequest{
+						MessageRequest: &topicspb.MessageRequest{
+							TopicName:  "MyTopic",
+							Message:    &message,
+							Attributes: map[string]string{"region": "ap-southeast-2"},
+						},
+					},
+				})).Return(&topicspb.ClientMessage{
+					Content: &topicspb.ClientMessage_MessageResponse{
+						MessageResponse: &topicspb.MessageResponse{
+							Success: true,
+						},
+					},
+				}, nil)
+
+				err := client.Start(&coreGateway.GatewayStartOpts{
+					TopicsListenerPlugin: mockManager,
+				})
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
 	Context("S3 Events", func() {
 		When("The Lambda Gateway receives S3 Put events", func() {
 			ctrl := gomock.NewController(GinkgoT())
@@ -379,7 +454,7 @@ var _ = Describe("Lambda", func() {
 				// mockHandler.EXPECT().HandlesTrigger(gomock.Any()).Return(true)
 
 				By("Handling a single Notification request")
-				mockManager.EXPECT().HandleRequest(&storagepb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &storagepb.ServerMessage{
 					Content: &storagepb.ServerMessage_BlobEventRequest{
 						BlobEventRequest: &storagepb.BlobEventRequest{
 							BucketName: "images",
@@ -452,7 +527,7 @@ var _ = Describe("Lambda", func() {
 				mockManager.EXPECT().WorkerCount().Return(1)
 
 				By("Handling a single Notification request")
-				mockManager.EXPECT().HandleRequest(&storagepb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &storagepb.ServerMessage{
 					Content: &storagepb.ServerMessage_BlobEventRequest{
 						BlobEventRequest: &storagepb.BlobEventRequest{
 							BucketName: "images",
@@ -472,6 +547,83 @@ var _ = Describe("Lambda", func() {
 					},
 				}, nil)
 
+				err := client.Start(&coreGateway.GatewayStartOpts{
+					StorageListenerPlugin: mockManager,
+				})
+				Expect(err).To(BeNil())
+			})
+		})
+		When("The Lambda Gateway receives S3 Restore events", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockResolver := mock_provider.NewMockAwsResourceResolver(ctrl)
+
+			mockManager := mock_storage.NewMockBucketRequestHandler(ctrl)
+
+			// pool := mock_pool.NewMockWorkerPool(ctrl)
+			// mockHandler := mock_worker.NewMockWorker(ctrl)
+
+			runtime := MockLambdaRuntime{
+				// Setup mock events for our runtime to process...
+				eventQueue: []interface{}{&events.S3Event{
+					Records: []events.S3EventRecord{
+						{
+							EventVersion: "",
+							EventSource:  "aws:s3",
+							EventName:    "ObjectRestore:Completed",
+							S3: events.S3Entity{
+								Bucket: events.S3Bucket{
+									Name: "images",
+									Arn:  "arn:aws:sns:us-east-1:12345678910:arn:images",
+								},
+								Object: events.S3Object{
+									Key:  "cat.png",
+									Size: 1024,
+									ETag: "d41d8cd98f00b204e9800998ecf8427e",
+								},
+							},
+							ResponseElements: map[string]string{},
+						},
+					},
+				}},
+			}
+
+			client := gateway.New(mockResolver, gateway.WithRuntime(runtime.Start))
+
+			// This function will block which means we don't need to wait on processing,
+			// the function will unblock once processing has finished, this is due to our mock
+			// handler only looping once over each request
+			It("The gateway should translate into a restored NitricRequest with the object's size and etag", func() {
+				By("The bucket existing")
+				mockResolver.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"images": {ARN: "arn:aws:sns:us-east-1:12345678910:arn:images"},
+				}, nil)
+
+				By("Having at least one worker")
+				mockManager.EXPECT().WorkerCount().Return(1)
+
+				By("Handling a single Notification request")
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &storagepb.ServerMessage{
+					Content: &storagepb.ServerMessage_BlobEventRequest{
+						BlobEventRequest: &storagepb.BlobEventRequest{
+							BucketName: "images",
+							Event: &storagepb.BlobEventRequest_BlobEvent{
+								BlobEvent: &storagepb.BlobEvent{
+									Key:  "cat.png",
+									Type: storagepb.BlobEventType_Restored,
+									Size: 1024,
+									Etag: "d41d8cd98f00b204e9800998ecf8427e",
+								},
+							},
+						},
+					},
+				}).Return(&storagepb.ClientMessage{
+					Content: &storagepb.ClientMessage_BlobEventResponse{
+						BlobEventResponse: &storagepb.BlobEventResponse{
+							Success: true,
+						},
+					},
+				}, nil)
+
 				err := client.Start(&coreGateway.GatewayStartOpts{
 					StorageListenerPlugin: mockManager,
 				})
@@ -30,6 +30,7 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
 	"github.com/nitrictech/nitric/core/pkg/workers/apis"
 	"github.com/nitrictech/nitric/core/pkg/workers/http"
 	"github.com/nitrictech/nitric/core/pkg/workers/schedules"
@@ -37,6 +38,7 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 	"github.com/nitrictech/nitric/core/pkg/workers/websockets"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -95,12 +97,23 @@ func handleSnsEvents(ctx context.Context, resolver resource.AwsResourceResolver,
 		attrs := map[string]string{}
 
 		for k, v := range snsRecord.SNS.MessageAttributes {
-			sv, ok := v.(string)
+			// SNS delivers message attributes to lambda as {"Type": "String", "Value": "..."}
+			attr, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			sv, ok := attr["Value"].(string)
 			if ok {
 				attrs[k] = sv
 			}
 		}
 
+		// remove the trace context added by the publisher
+		for _, field := range (xray.Propagator{}).Fields() {
+			delete(attrs, field)
+		}
+
 		tName, err := getTopicNameForArn(ctx, resolver, snsRecord.SNS.TopicArn)
 		if err != nil {
 			logger.Errorf("unable to find nitric topic: %v", err)
@@ -123,13 +136,14 @@ func handleSnsEvents(ctx context.Context, resolver resource.AwsResourceResolver,
 		request := &topicspb.ServerMessage{
 			Content: &topicspb.ServerMessage_MessageRequest{
 				MessageRequest: &topicspb.MessageRequest{
-					TopicName: tName,
-					Message:   &message,
+					TopicName:  tName,
+					Message:    &message,
+					Attributes: attrs,
 				},
 			},
 		}
 
-		resp, err := subscriptions.HandleRequest(request)
+		resp, err := subscriptions.HandleRequest(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +171,7 @@ func handleScheduleEvent(ctx context.Context, schedules schedules.ScheduleReques
 		},
 	}
 
-	_, err := schedules.HandleRequest(request)
+	_, err := schedules.HandleRequest(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -276,8 +290,16 @@ func handleApiGatewayRequest(ctx context.Context, nitricName string, apismanager
 		},
 	}
 
-	resp, err := apismanager.HandleRequest(nitricName, req)
+	resp, err := apismanager.HandleRequest(ctx, nitricName, req)
 	if err != nil {
+		if workers.IsWorkerTimeout(err) {
+			return events.APIGatewayProxyResponse{
+				StatusCode:      504,
+				Body:            "Gateway Timeout",
+				IsBase64Encoded: false,
+			}, nil
+		}
+
 		return events.APIGatewayProxyResponse{
 			StatusCode:      500,
 			Body:            "Internal Server Error",
@@ -358,8 +380,16 @@ func handleWebsocketEvent(ctx context.Context, resolver resource.AwsResourceReso
 		Content: wsEvent,
 	}
 
-	resp, err := websockets.HandleRequest(req)
+	resp, err := websockets.HandleRequest(ctx, req)
 	if err != nil {
+		if workers.IsWorkerTimeout(err) {
+			return events.APIGatewayProxyResponse{
+				StatusCode:      504,
+				Body:            "gateway timeout",
+				IsBase64Encoded: false,
+			}, nil
+		}
+
 		return events.APIGatewayProxyResponse{
 			StatusCode:      500,
 			Body:            "error processing lambda request",
@@ -386,8 +416,13 @@ func s3EventTypeToNitricBlobEventType(eventType string) (*storagepb.BlobEventTyp
 		return storagepb.BlobEventType_Created.Enum(), nil
 	} else if ok := strings.Contains(eventType, "ObjectRemoved:"); ok {
 		return storagepb.BlobEventType_Deleted.Enum(), nil
+	} else if ok := strings.Contains(eventType, "ObjectTagging:"); ok {
+		// S3 only reports changes to object tags, metadata can only be changed by replacing the object
+		return storagepb.BlobEventType_MetadataUpdated.Enum(), nil
+	} else if ok := strings.Contains(eventType, "ObjectRestore:Completed"); ok {
+		return storagepb.BlobEventType_Restored.Enum(), nil
 	}
-	return nil, fmt.Errorf("unsupported blob event type, expected ObjectCreated or ObjectRemoved, got %s", eventType)
+	return nil, fmt.Errorf("unsupported blob event type, expected ObjectCreated, ObjectRemoved, ObjectTagging or ObjectRestore:Completed, got %s", eventType)
 }
 
 func handleS3Event(ctx context.Context, resolver resource.AwsResourceResolver, storageListeners storage.BucketRequestHandler, records []Record) (interface{}, error) {
@@ -411,13 +446,15 @@ func handleS3Event(ctx context.Context, resolver resource.AwsResourceResolver, s
 						BlobEvent: &storagepb.BlobEvent{
 							Key:  s3Record.S3.Object.Key,
 							Type: *eventType,
+							Size: s3Record.S3.Object.Size,
+							Etag: s3Record.S3.Object.ETag,
 						},
 					},
 				},
 			},
 		}
 
-		resp, err := storageListeners.HandleRequest(msg)
+		resp, err := storageListeners.HandleRequest(ctx, msg)
 		if err != nil {
 			return nil, err
 		}
@@ -19,13 +19,16 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -38,13 +41,24 @@ import (
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 )
 
+// AttribExpiry is the table's TTL attribute, holding the unix time in seconds an expiring value expires at.
+// AttribVersion holds a random version, replaced every time the value is set.
 const (
 	AttribPk         = "_pk"
 	AttribSk         = "_sk"
+	AttribExpiry     = "_expiry"
+	AttribVersion    = "_version"
 	deleteQueryLimit = int32(1000)
+	maxBatchGet      = 100
 	maxBatchWrite    = 25
 )
 
+// Unprocessed batch items are retried with an exponential backoff, and reported as unavailable once retries are exhausted
+const (
+	maxBatchRetries   = 3
+	batchRetryBackoff = 50 * time.Millisecond
+)
+
 // DynamoKeyValueService - an AWS DynamoDB implementation of the Nitric Document Service
 type DynamoKeyValueService struct {
 	client   dynamodbiface.DynamoDBAPI
@@ -63,6 +77,19 @@ func isDynamoAccessDeniedErr(err error) bool {
 	return false
 }
 
+func isDynamoConditionFailedErr(err error) bool {
+	var condErr *types.ConditionalCheckFailedException
+	return errors.As(err, &condErr)
+}
+
+// notExpired is a condition excluding items whose ttl has passed but haven't been removed by DynamoDB yet
+func notExpired(now time.Time) expression.ConditionBuilder {
+	return expression.Or(
+		expression.Name(AttribExpiry).AttributeNotExists(),
+		expression.Name(AttribExpiry).GreaterThan(expression.Value(now.Unix())),
+	)
+}
+
 // Get a document from the DynamoDB table
 func (s *DynamoKeyValueService) GetValue(ctx context.Context, req *kvstorepb.KvStoreGetValueRequest) (*kvstorepb.KvStoreGetValueResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.Get")
@@ -121,8 +148,7 @@ func (s *DynamoKeyValueService) GetValue(ctx context.Context, req *kvstorepb.KvS
 		)
 	}
 
-	var itemMap map[string]interface{}
-	err = attributevalue.UnmarshalMap(result.Item, &itemMap)
+	value, err := valueFromItem(req.Ref, result.Item, time.Now())
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -131,23 +157,16 @@ func (s *DynamoKeyValueService) GetValue(ctx context.Context, req *kvstorepb.KvS
 		)
 	}
 
-	delete(itemMap, AttribPk)
-	delete(itemMap, AttribSk)
-
-	documentContent, err := structpb.NewStruct(itemMap)
-	if err != nil {
+	if value == nil {
 		return nil, newErr(
-			codes.Internal,
-			"error converting returned document to struct",
-			err,
+			codes.NotFound,
+			fmt.Sprintf("key %s not found in store %s", req.Ref.Key, req.Ref.Store),
+			nil,
 		)
 	}
 
 	return &kvstorepb.KvStoreGetValueResponse{
-		Value: &kvstorepb.Value{
-			Ref:     req.Ref,
-			Content: documentContent,
-		},
+		Value: value,
 	}, nil
 }
 
@@ -171,8 +190,30 @@ func (s *DynamoKeyValueService) SetValue(ctx context.Context, req *kvstorepb.KvS
 		)
 	}
 
+	if err := document.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid ttl",
+			err,
+		)
+	}
+
+	if err := document.ValidateConditions(req.IfMatch, req.IfNotExists); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid conditions",
+			err,
+		)
+	}
+
+	now := time.Now()
+
 	// Construct DynamoDB attribute value object
 	itemMap := createItemMap(req.Content.AsMap(), req.Ref)
+	itemMap[AttribVersion] = uuid.NewString()
+	if ttl := req.Ttl.AsDuration(); ttl > 0 {
+		itemMap[AttribExpiry] = now.Add(ttl).Unix()
+	}
 	itemAttributeMap, err := attributevalue.MarshalMap(itemMap)
 	if err != nil {
 		return nil, newErr(
@@ -196,8 +237,44 @@ func (s *DynamoKeyValueService) SetValue(ctx context.Context, req *kvstorepb.KvS
 		TableName: tableName,
 	}
 
+	var condition *expression.ConditionBuilder
+	if req.IfNotExists {
+		// expired items that haven't been removed yet don't count as existing
+		notExists := expression.Or(
+			expression.Name(AttribPk).AttributeNotExists(),
+			expression.Name(AttribExpiry).LessThanEqual(expression.Value(now.Unix())),
+		)
+		condition = &notExists
+	} else if req.IfMatch != "" {
+		matches := expression.Name(AttribVersion).Equal(expression.Value(req.IfMatch)).And(notExpired(now))
+		condition = &matches
+	}
+
+	if condition != nil {
+		expr, err := expression.NewBuilder().WithCondition(*condition).Build()
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"unable to build set condition expression",
+				err,
+			)
+		}
+
+		input.ConditionExpression = expr.Condition()
+		input.ExpressionAttributeNames = expr.Names()
+		input.ExpressionAttributeValues = expr.Values()
+	}
+
 	_, err = s.client.PutItem(ctx, input)
 	if err != nil {
+		if isDynamoConditionFailedErr(err) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				fmt.Sprintf("value with key %s in store %s does not satisfy the set conditions", req.Ref.Key, req.Ref.Store),
+				err,
+			)
+		}
+
 		if isDynamoAccessDeniedErr(err) {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -252,8 +329,32 @@ func (s *DynamoKeyValueService) DeleteKey(ctx context.Context, req *kvstorepb.Kv
 		TableName: tableName,
 	}
 
+	if req.IfMatch != "" {
+		matches := expression.Name(AttribVersion).Equal(expression.Value(req.IfMatch)).And(notExpired(time.Now()))
+		expr, err := expression.NewBuilder().WithCondition(matches).Build()
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"unable to build delete condition expression",
+				err,
+			)
+		}
+
+		deleteInput.ConditionExpression = expr.Condition()
+		deleteInput.ExpressionAttributeNames = expr.Names()
+		deleteInput.ExpressionAttributeValues = expr.Values()
+	}
+
 	_, err = s.client.DeleteItem(ctx, deleteInput)
 	if err != nil {
+		if isDynamoConditionFailedErr(err) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch),
+				err,
+			)
+		}
+
 		if isDynamoAccessDeniedErr(err) {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -283,6 +384,14 @@ func (s *DynamoKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKeysRequest,
 		)
 	}
 
+	if err := document.ValidateScanLimit(req.Limit); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid limit",
+			err,
+		)
+	}
+
 	tableName, err := s.getTableName(context.TODO(), req.Store.Name)
 	if err != nil {
 		return newErr(
@@ -293,7 +402,15 @@ func (s *DynamoKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKeysRequest,
 	}
 
 	projection := expression.NamesList(expression.Name(AttribPk))
-	filter := expression.Name(AttribPk).BeginsWith(req.Prefix)
+	// expired items are excluded until DynamoDB removes them
+	filter := expression.Name(AttribPk).BeginsWith(req.Prefix).And(notExpired(time.Now()))
+	if req.StartAfter != "" {
+		if req.Reverse {
+			filter = filter.And(expression.Name(AttribPk).LessThan(expression.Value(req.StartAfter)))
+		} else {
+			filter = filter.And(expression.Name(AttribPk).GreaterThan(expression.Value(req.StartAfter)))
+		}
+	}
 	expr, err := expression.NewBuilder().WithFilter(filter).WithProjection(projection).Build()
 	if err != nil {
 		return newErr(
@@ -311,7 +428,8 @@ func (s *DynamoKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKeysRequest,
 		ExpressionAttributeValues: expr.Values(),
 	}
 
-	// Get all keys from the table
+	// keys are the table's partition key, so a scan returns them unordered and every matching key is read before a page can be returned
+	keys := []string{}
 	paginator := dynamodb.NewScanPaginator(s.client, input)
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(context.Background())
@@ -342,21 +460,222 @@ func (s *DynamoKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKeysRequest,
 				)
 			}
 
-			if err := stream.Send(&kvstorepb.KvStoreScanKeysResponse{
-				Key: itemMap[AttribPk].(string),
-			}); err != nil {
-				return newErr(
-					codes.Internal,
-					"failed to send response",
-					err,
-				)
-			}
+			keys = append(keys, itemMap[AttribPk].(string))
 		}
 	}
 
+	keys, continuationToken := document.PageKeys(keys, req)
+
+	if err := document.SendKeys(stream, keys, continuationToken); err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to send response",
+			err,
+		)
+	}
+
 	return nil
 }
 
+// GetValues retrieves multiple values from DynamoDB tables, reporting a result for each key
+func (s *DynamoKeyValueService) GetValues(ctx context.Context, req *kvstorepb.KvStoreGetValuesRequest) (*kvstorepb.KvStoreGetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.GetValues")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	tableNames, err := s.getTableNames(ctx)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to find tables",
+			err,
+		)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Refs))
+	gets := make([]batchGet, 0, len(req.Refs))
+	for i, ref := range req.Refs {
+		tableName, key, err := batchKeyMap(tableNames, ref)
+		if err != nil {
+			results[i] = document.BatchResult(ref, nil, err)
+			continue
+		}
+
+		gets = append(gets, batchGet{tableName: tableName, key: key})
+	}
+
+	items, errs := s.getBatches(ctx, gets)
+
+	now := time.Now()
+	for i, ref := range req.Refs {
+		if results[i] != nil {
+			continue
+		}
+
+		key := refBatchKey(ref)
+		if err, ok := errs[key]; ok {
+			results[i] = document.BatchResult(ref, nil, err)
+			continue
+		}
+
+		var value *kvstorepb.Value
+		if item, ok := items[key]; ok {
+			value, err = valueFromItem(ref, item, now)
+			if err != nil {
+				results[i] = document.BatchResult(ref, nil, newErr(codes.Internal, "error unmarshalling item", err))
+				continue
+			}
+		}
+
+		if value == nil {
+			results[i] = document.BatchResult(ref, nil, newErr(codes.NotFound, fmt.Sprintf("key %s not found in store %s", ref.Key, ref.Store), nil))
+			continue
+		}
+
+		results[i] = document.BatchResult(ref, value, nil)
+	}
+
+	return &kvstorepb.KvStoreGetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// SetValues creates or overwrites multiple values in DynamoDB tables, reporting a result for each key
+func (s *DynamoKeyValueService) SetValues(ctx context.Context, req *kvstorepb.KvStoreSetValuesRequest) (*kvstorepb.KvStoreSetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.SetValues")
+
+	refs := make([]*kvstorepb.ValueRef, 0, len(req.Values))
+	for _, value := range req.Values {
+		refs = append(refs, value.GetRef())
+	}
+
+	if err := document.ValidateValueRefs(refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	if err := document.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid ttl",
+			err,
+		)
+	}
+
+	tableNames, err := s.getTableNames(ctx)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to find tables",
+			err,
+		)
+	}
+
+	now := time.Now()
+
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Values))
+	writes := make([]batchWrite, 0, len(req.Values))
+	for i, value := range req.Values {
+		if value.Content == nil {
+			results[i] = document.BatchResult(value.Ref, nil, newErr(codes.InvalidArgument, "document content must not be nil", nil))
+			continue
+		}
+
+		tableName, _, err := batchKeyMap(tableNames, value.Ref)
+		if err != nil {
+			results[i] = document.BatchResult(value.Ref, nil, err)
+			continue
+		}
+
+		itemMap := createItemMap(value.Content.AsMap(), value.Ref)
+		itemMap[AttribVersion] = uuid.NewString()
+		if ttl := req.Ttl.AsDuration(); ttl > 0 {
+			itemMap[AttribExpiry] = now.Add(ttl).Unix()
+		}
+
+		item, err := attributevalue.MarshalMap(itemMap)
+		if err != nil {
+			results[i] = document.BatchResult(value.Ref, nil, newErr(codes.InvalidArgument, "failed to marshal content", err))
+			continue
+		}
+
+		writes = append(writes, batchWrite{
+			tableName: tableName,
+			request:   types.WriteRequest{PutRequest: &types.PutRequest{Item: item}},
+		})
+	}
+
+	errs := s.writeBatches(ctx, writes)
+
+	for i, value := range req.Values {
+		if results[i] == nil {
+			results[i] = document.BatchResult(value.Ref, nil, errs[refBatchKey(value.Ref)])
+		}
+	}
+
+	return &kvstorepb.KvStoreSetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// DeleteKeys removes multiple keys from DynamoDB tables, reporting a result for each key
+func (s *DynamoKeyValueService) DeleteKeys(ctx context.Context, req *kvstorepb.KvStoreDeleteKeysRequest) (*kvstorepb.KvStoreDeleteKeysResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.DeleteKeys")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	tableNames, err := s.getTableNames(ctx)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to find tables",
+			err,
+		)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Refs))
+	writes := make([]batchWrite, 0, len(req.Refs))
+	for i, ref := range req.Refs {
+		tableName, key, err := batchKeyMap(tableNames, ref)
+		if err != nil {
+			results[i] = document.BatchResult(ref, nil, err)
+			continue
+		}
+
+		writes = append(writes, batchWrite{
+			tableName: tableName,
+			request:   types.WriteRequest{DeleteRequest: &types.DeleteRequest{Key: key}},
+		})
+	}
+
+	errs := s.writeBatches(ctx, writes)
+
+	for i, ref := range req.Refs {
+		if results[i] == nil {
+			results[i] = document.BatchResult(ref, nil, errs[refBatchKey(ref)])
+		}
+	}
+
+	return &kvstorepb.KvStoreDeleteKeysResponse{
+		Results: results,
+	}, nil
+}
+
 // New creates a new AWS DynamoDB implementation of a DocumentServiceServer
 func New(resolver resource.AwsResourceResolver) (*DynamoKeyValueService, error) {
 	awsRegion := env.AWS_REGION.String()
@@ -412,18 +731,249 @@ func createItemMap(source map[string]interface{}, ref *kvstorepb.ValueRef) map[s
 	return newMap
 }
 
-func (s *DynamoKeyValueService) getTableName(ctx context.Context, store string) (*string, error) {
+// valueFromItem converts a DynamoDB item to a value, returning nil if the item has expired
+func valueFromItem(ref *kvstorepb.ValueRef, item map[string]types.AttributeValue, now time.Time) (*kvstorepb.Value, error) {
+	var itemMap map[string]interface{}
+	if err := attributevalue.UnmarshalMap(item, &itemMap); err != nil {
+		return nil, err
+	}
+
+	// DynamoDB removes expired items eventually, so they may still be returned for some time after expiring
+	if expiry, ok := itemMap[AttribExpiry].(float64); ok && int64(expiry) <= now.Unix() {
+		return nil, nil
+	}
+
+	version, _ := itemMap[AttribVersion].(string)
+
+	delete(itemMap, AttribPk)
+	delete(itemMap, AttribSk)
+	delete(itemMap, AttribExpiry)
+	delete(itemMap, AttribVersion)
+
+	content, err := structpb.NewStruct(itemMap)
+	if err != nil {
+		return nil, fmt.Errorf("error converting returned document to struct: %w", err)
+	}
+
+	return &kvstorepb.Value{
+		Ref:     ref,
+		Content: content,
+		Version: version,
+	}, nil
+}
+
+// getTableNames returns the DynamoDB table name of every store, keyed by store name
+func (s *DynamoKeyValueService) getTableNames(ctx context.Context) (map[string]*string, error) {
 	tables, err := s.resolver.GetResources(ctx, resource.AwsResource_Collection)
 	if err != nil {
 		return nil, fmt.Errorf("encountered an error retrieving the table list: %w", err)
 	}
 
-	if table, ok := tables[store]; ok {
-		tableName := strings.Split(table.ARN, "/")[1]
-
+	tableNames := make(map[string]*string, len(tables))
+	for store, table := range tables {
 		// split the table arn to get the name
-		return aws.String(tableName), nil
+		tableNames[store] = aws.String(strings.Split(table.ARN, "/")[1])
+	}
+
+	return tableNames, nil
+}
+
+func (s *DynamoKeyValueService) getTableName(ctx context.Context, store string) (*string, error) {
+	tableNames, err := s.getTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tableName, ok := tableNames[store]; ok {
+		return tableName, nil
 	}
 
 	return nil, fmt.Errorf("store %s does not exist", store)
 }
+
+// batchKey identifies an item in a batch, keys are unique across tables as the sort key includes the store name
+type batchKey struct {
+	pk string
+	sk string
+}
+
+// batchGet is a key to retrieve in a batch, along with its table
+type batchGet struct {
+	tableName string
+	key       map[string]types.AttributeValue
+}
+
+// batchWrite is a put or delete in a batch, along with its table
+type batchWrite struct {
+	tableName string
+	request   types.WriteRequest
+}
+
+func refBatchKey(ref *kvstorepb.ValueRef) batchKey {
+	keyMap := createKeyMap(ref)
+
+	return batchKey{pk: keyMap[AttribPk], sk: keyMap[AttribSk]}
+}
+
+func itemBatchKey(item map[string]types.AttributeValue) batchKey {
+	var key batchKey
+
+	if pk, ok := item[AttribPk].(*types.AttributeValueMemberS); ok {
+		key.pk = pk.Value
+	}
+
+	if sk, ok := item[AttribSk].(*types.AttributeValueMemberS); ok {
+		key.sk = sk.Value
+	}
+
+	return key
+}
+
+func writeRequestBatchKey(request types.WriteRequest) batchKey {
+	if request.PutRequest != nil {
+		return itemBatchKey(request.PutRequest.Item)
+	}
+
+	return itemBatchKey(request.DeleteRequest.Key)
+}
+
+// batchKeyMap resolves the table and marshalled key of a value in a batch
+func batchKeyMap(tableNames map[string]*string, ref *kvstorepb.ValueRef) (string, map[string]types.AttributeValue, error) {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.batchKeyMap")
+
+	tableName, ok := tableNames[ref.Store]
+	if !ok {
+		return "", nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("store %s does not exist", ref.Store),
+			nil,
+		)
+	}
+
+	key, err := attributevalue.MarshalMap(createKeyMap(ref))
+	if err != nil {
+		return "", nil, newErr(
+			codes.InvalidArgument,
+			"failed to marshal key",
+			err,
+		)
+	}
+
+	return *tableName, key, nil
+}
+
+// batchErr converts the error of a batch request to the error reported for each of its keys
+func batchErr(newErr grpc_errors.ScopedErrorFactory, err error) error {
+	if isDynamoAccessDeniedErr(err) {
+		return newErr(
+			codes.PermissionDenied,
+			"unable to complete batch request, this may be due to a missing permissions request in your code.",
+			err,
+		)
+	}
+
+	return newErr(
+		codes.Internal,
+		"unable to complete batch request",
+		err,
+	)
+}
+
+// getBatches retrieves items in batches of at most maxBatchGet, returning the items found and the error for each key that couldn't be retrieved
+func (s *DynamoKeyValueService) getBatches(ctx context.Context, gets []batchGet) (map[batchKey]map[string]types.AttributeValue, map[batchKey]error) {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.getBatches")
+
+	items := make(map[batchKey]map[string]types.AttributeValue, len(gets))
+	errs := make(map[batchKey]error)
+
+	for start := 0; start < len(gets); start += maxBatchGet {
+		requestItems := make(map[string]types.KeysAndAttributes)
+		for _, get := range gets[start:min(start+maxBatchGet, len(gets))] {
+			keys := requestItems[get.tableName]
+			keys.Keys = append(keys.Keys, get.key)
+			requestItems[get.tableName] = keys
+		}
+
+		var err error
+		for attempt := 0; len(requestItems) > 0 && attempt <= maxBatchRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(batchRetryBackoff << attempt)
+			}
+
+			var out *dynamodb.BatchGetItemOutput
+			out, err = s.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				break
+			}
+
+			for _, tableItems := range out.Responses {
+				for _, item := range tableItems {
+					items[itemBatchKey(item)] = item
+				}
+			}
+
+			requestItems = out.UnprocessedKeys
+		}
+
+		if err != nil {
+			err = batchErr(newErr, err)
+		} else {
+			err = newErr(codes.Unavailable, "key was left unprocessed after retrying, try again later", nil)
+		}
+
+		for _, keys := range requestItems {
+			for _, key := range keys.Keys {
+				errs[itemBatchKey(key)] = err
+			}
+		}
+	}
+
+	return items, errs
+}
+
+// writeBatches performs writes in batches of at most maxBatchWrite, returning the error for each key that couldn't be written
+func (s *DynamoKeyValueService) writeBatches(ctx context.Context, writes []batchWrite) map[batchKey]error {
+	newErr := grpc_errors.ErrorsWithScope("DynamoDocService.writeBatches")
+
+	errs := make(map[batchKey]error)
+
+	for start := 0; start < len(writes); start += maxBatchWrite {
+		requestItems := make(map[string][]types.WriteRequest)
+		for _, write := range writes[start:min(start+maxBatchWrite, len(writes))] {
+			requestItems[write.tableName] = append(requestItems[write.tableName], write.request)
+		}
+
+		var err error
+		for attempt := 0; len(requestItems) > 0 && attempt <= maxBatchRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(batchRetryBackoff << attempt)
+			}
+
+			var out *dynamodb.BatchWriteItemOutput
+			out, err = s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				break
+			}
+
+			requestItems = out.UnprocessedItems
+		}
+
+		if err != nil {
+			err = batchErr(newErr, err)
+		} else {
+			err = newErr(codes.Unavailable, "key was left unprocessed after retrying, try again later", nil)
+		}
+
+		for _, requests := range requestItems {
+			for _, request := range requests {
+				errs[writeRequestBatchKey(request)] = err
+			}
+		}
+	}
+
+	return errs
+}
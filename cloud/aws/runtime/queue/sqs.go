@@ -19,7 +19,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -34,11 +37,19 @@ import (
 	"github.com/nitrictech/nitric/cloud/aws/ifaces/sqsiface"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/resource"
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
 )
 
+// SQS limits on message delays, visibility timeouts and long polling
+const (
+	maxDelay             = 15 * time.Minute
+	maxVisibilityTimeout = 12 * time.Hour
+	maxWaitTime          = 20 * time.Second
+)
+
 type SQSQueueService struct {
 	provider resource.AwsResourceResolver
 	client   sqsiface.SQSAPI
@@ -74,6 +85,17 @@ func (s *SQSQueueService) getUrlForQueueName(ctx context.Context, queue string)
 	return out.QueueUrl, nil
 }
 
+// toSeconds converts a duration to whole seconds for SQS, rounding up so it's never shorter than requested
+func toSeconds(duration time.Duration) int32 {
+	return int32(math.Ceil(duration.Seconds()))
+}
+
+func isSQSLeaseNotFoundErr(err error) bool {
+	var invalidErr *types.ReceiptHandleIsInvalid
+	var notInflightErr *types.MessageNotInflight
+	return errors.As(err, &invalidErr) || errors.As(err, &notInflightErr)
+}
+
 func isSQSAccessDeniedErr(err error) bool {
 	var opErr *smithy.OperationError
 	if errors.As(err, &opErr) {
@@ -86,6 +108,7 @@ func (s *SQSQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnqueu
 	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.Enqueue")
 
 	requestIdMap := map[string]*queuespb.QueueMessage{}
+	failedTasks := make([]*queuespb.FailedEnqueueMessage, 0)
 
 	if url, err := s.getUrlForQueueName(ctx, req.QueueName); err == nil {
 		entries := make([]types.SendMessageBatchRequestEntry, 0)
@@ -93,16 +116,26 @@ func (s *SQSQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnqueu
 		for _, sendTaskReq := range req.Messages {
 			t := sendTaskReq
 
+			if err := queue.ValidateDelay(t.Delay, maxDelay); err != nil {
+				failedTasks = append(failedTasks, &queuespb.FailedEnqueueMessage{
+					Message: t,
+					Details: err.Error(),
+				})
+				continue
+			}
+
 			// generate a unique Id for each task
 			id := uuid.New()
 			requestIdMap[id.String()] = t
 
-			if bytes, err := proto.Marshal(t); err == nil {
+			// only the content and its type are sent, the delay is set on the SQS message instead
+			if bytes, err := proto.Marshal(&queuespb.QueueMessage{Content: t.Content, ContentType: t.ContentType}); err == nil {
 				msgString := base64.StdEncoding.EncodeToString(bytes)
 
 				entries = append(entries, types.SendMessageBatchRequestEntry{
-					Id:          aws.String(id.String()),
-					MessageBody: aws.String(msgString),
+					Id:           aws.String(id.String()),
+					MessageBody:  aws.String(msgString),
+					DelaySeconds: toSeconds(t.Delay.AsDuration()),
 				})
 			} else {
 				return nil, newErr(
@@ -113,12 +146,18 @@ func (s *SQSQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnqueu
 			}
 		}
 
+		// SQS rejects empty batches, which are left when every message failed validation
+		if len(entries) == 0 {
+			return &queuespb.QueueEnqueueResponse{
+				FailedMessages: failedTasks,
+			}, nil
+		}
+
 		if out, err := s.client.SendMessageBatch(ctx, &sqs.SendMessageBatchInput{
 			Entries:  entries,
 			QueueUrl: url,
 		}); err == nil {
 			// process out Failed messages to return to the user...
-			failedTasks := make([]*queuespb.FailedEnqueueMessage, 0, len(out.Failed))
 			for _, failed := range out.Failed {
 				for id, e := range requestIdMap {
 					if id == *failed.Id {
@@ -162,15 +201,36 @@ func (s *SQSQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnqueu
 func (s *SQSQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeueRequest) (*queuespb.QueueDequeueResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.Dequeue")
 
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	if err := queue.ValidateWait(req.Wait, maxWaitTime); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid wait time",
+			err,
+		)
+	}
+
 	if url, err := s.getUrlForQueueName(ctx, req.QueueName); err == nil {
 		req := sqs.ReceiveMessageInput{
 			MaxNumberOfMessages: req.Depth,
+			AttributeNames: []types.QueueAttributeName{
+				types.QueueAttributeName(types.MessageSystemAttributeNameApproximateReceiveCount),
+			},
 			MessageAttributeNames: []string{
 				string(types.QueueAttributeNameAll),
 			},
 			QueueUrl: url,
-			// VisibilityTimeout:       nil,
-			// WaitTimeSeconds:         nil,
+			// the queue's default visibility timeout is used when no lease duration is requested
+			VisibilityTimeout: toSeconds(req.LeaseDuration.AsDuration()),
+			// long polls until messages are available or the wait time elapses, returning immediately if unset
+			WaitTimeSeconds: toSeconds(req.Wait.AsDuration()),
 		}
 
 		res, err := s.client.ReceiveMessage(ctx, &req)
@@ -212,9 +272,13 @@ func (s *SQSQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeu
 				)
 			}
 
+			// the receive count is approximate, as SQS is distributed
+			receiveCount, _ := strconv.Atoi(m.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)])
+
 			tasks = append(tasks, &queuespb.DequeuedMessage{
-				LeaseId: *m.ReceiptHandle,
-				Message: &queueMessage,
+				LeaseId:      *m.ReceiptHandle,
+				Message:      &queueMessage,
+				ReceiveCount: int32(receiveCount),
 			})
 		}
 
@@ -258,6 +322,100 @@ func (s *SQSQueueService) Complete(ctx context.Context, req *queuespb.QueueCompl
 	}
 }
 
+// ExtendLease on a previously popped queue item, by changing its visibility timeout
+func (s *SQSQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.ExtendLease")
+
+	if req.LeaseDuration == nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"lease duration is required",
+			nil,
+		)
+	}
+
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	if err := s.changeVisibility(ctx, req.QueueName, req.LeaseId, req.LeaseDuration.AsDuration()); err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: req.LeaseId,
+	}, nil
+}
+
+// Release a previously popped queue item without completing it, by changing its visibility timeout to the requested delay
+func (s *SQSQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.Release")
+
+	// the visibility timeout of an in flight message can be set up to the same maximum as its lease
+	if err := queue.ValidateDelay(req.Delay, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid delay",
+			err,
+		)
+	}
+
+	if err := s.changeVisibility(ctx, req.QueueName, req.LeaseId, req.Delay.AsDuration()); err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// changeVisibility sets how long until a leased message becomes visible again, starting from now
+func (s *SQSQueueService) changeVisibility(ctx context.Context, queueName string, leaseId string, timeout time.Duration) error {
+	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.changeVisibility")
+
+	url, err := s.getUrlForQueueName(ctx, queueName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	_, err = s.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          url,
+		ReceiptHandle:     aws.String(leaseId),
+		VisibilityTimeout: toSeconds(timeout),
+	})
+	if err != nil {
+		if isSQSLeaseNotFoundErr(err) {
+			return newErr(
+				codes.NotFound,
+				fmt.Sprintf("no message with lease %s on queue %s", leaseId, queueName),
+				err,
+			)
+		}
+
+		if isSQSAccessDeniedErr(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to change message visibility, have you requested access to this queue?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"failed to change message visibility",
+			err,
+		)
+	}
+
+	return nil
+}
+
 func New(provider resource.AwsResourceResolver) (queuespb.QueuesServer, error) {
 	awsRegion := env.AWS_REGION.String()
 
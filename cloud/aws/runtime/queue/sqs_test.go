@@ -19,13 +19,17 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	. "github.com/onsi/ginkgo"
@@ -141,6 +145,56 @@ var _ = Describe("Sqs", func() {
 			})
 		})
 
+		When("Sending a bytes payload", func() {
+			It("Should send the payload with its content type", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+				providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+				plugin := NewWithClient(providerMock, sqsMock)
+
+				bytesMessage := &queuespb.QueueMessage{
+					Content: &queuespb.QueueMessage_BytesPayload{
+						BytesPayload: []byte("test"),
+					},
+					ContentType: "text/plain",
+				}
+
+				By("The queue being available")
+				providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+					"test-queue": {
+						ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+					},
+				}, nil)
+
+				sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+					QueueUrl: aws.String("https://example.com/test-queue"),
+				}, nil)
+
+				var sentBody string
+				By("Calling SendMessageBatch with the encoded message")
+				sqsMock.EXPECT().SendMessageBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sqs.SendMessageBatchInput, opts ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error) {
+					sentBody = *input.Entries[0].MessageBody
+					return &sqs.SendMessageBatchOutput{}, nil
+				})
+
+				_, err := plugin.Enqueue(context.TODO(), &queuepb.QueueEnqueueRequest{
+					QueueName: "test-queue",
+					Messages:  []*queuespb.QueueMessage{bytesMessage},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Encoding the payload and content type in the message body")
+				bodyBytes, err := base64.StdEncoding.DecodeString(sentBody)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				var sent queuespb.QueueMessage
+				Expect(proto.Unmarshal(bodyBytes, &sent)).To(Succeed())
+				Expect(sent.GetBytesPayload()).To(Equal([]byte("test")))
+				Expect(sent.ContentType).To(Equal("text/plain"))
+				ctrl.Finish()
+			})
+		})
+
 		When("Permission to access the queue is missing", func() {
 			It("Should return an error", func() {
 				ctrl := gomock.NewController(GinkgoT())
@@ -254,6 +308,9 @@ var _ = Describe("Sqs", func() {
 					By("Calling ReceiveMessage with the expected inputs")
 					sqsMock.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{
 						MaxNumberOfMessages: int32(10),
+						AttributeNames: []types.QueueAttributeName{
+							types.QueueAttributeName(types.MessageSystemAttributeNameApproximateReceiveCount),
+						},
 						MessageAttributeNames: []string{
 							string(types.QueueAttributeNameAll),
 						},
@@ -263,6 +320,9 @@ var _ = Describe("Sqs", func() {
 							{
 								ReceiptHandle: aws.String("mockreceipthandle"),
 								Body:          aws.String(testPayloadB64),
+								Attributes: map[string]string{
+									"ApproximateReceiveCount": "2",
+								},
 							},
 						},
 					}, nil)
@@ -276,6 +336,7 @@ var _ = Describe("Sqs", func() {
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(response.Messages).To(HaveLen(1))
 					Expect(response.Messages[0].LeaseId).To(BeEquivalentTo("mockreceipthandle"))
+					Expect(response.Messages[0].ReceiveCount).To(BeEquivalentTo(2))
 					Expect(response.Messages[0].Message.GetStructPayload().AsMap()).To(BeEquivalentTo(testStruct.GetStructPayload().AsMap()))
 
 					ctrl.Finish()
@@ -306,6 +367,9 @@ var _ = Describe("Sqs", func() {
 					By("Calling ReceiveMessage with the expected inputs")
 					sqsMock.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{
 						MaxNumberOfMessages: int32(10),
+						AttributeNames: []types.QueueAttributeName{
+							types.QueueAttributeName(types.MessageSystemAttributeNameApproximateReceiveCount),
+						},
 						MessageAttributeNames: []string{
 							string(types.QueueAttributeNameAll),
 						},
@@ -329,6 +393,72 @@ var _ = Describe("Sqs", func() {
 				})
 			})
 
+			When("A wait time is requested", func() {
+				It("Should long poll for the wait time", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					By("Calling GetResources to get the queue arn")
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"mock-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:mock-queue",
+						},
+					}, nil)
+
+					By("Calling GetQueueUrl to get the queue url")
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Calling ReceiveMessage with the wait time in seconds")
+					sqsMock.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{
+						MaxNumberOfMessages: int32(10),
+						AttributeNames: []types.QueueAttributeName{
+							types.QueueAttributeName(types.MessageSystemAttributeNameApproximateReceiveCount),
+						},
+						MessageAttributeNames: []string{
+							string(types.QueueAttributeNameAll),
+						},
+						QueueUrl:        queueUrl,
+						WaitTimeSeconds: int32(5),
+					}).Times(1).Return(&sqs.ReceiveMessageOutput{
+						Messages: []types.Message{},
+					}, nil)
+
+					response, err := plugin.Dequeue(context.TODO(), &queuepb.QueueDequeueRequest{
+						QueueName: "mock-queue",
+						Depth:     10,
+						Wait:      durationpb.New(5 * time.Second),
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.Messages).To(HaveLen(0))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("The wait time is longer than SQS allows", func() {
+				It("Should return an invalid argument error", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					plugin := NewWithClient(mock_provider.NewMockAwsResourceResolver(ctrl), mocks_sqs.NewMockSQSAPI(ctrl))
+
+					_, err := plugin.Dequeue(context.TODO(), &queuepb.QueueDequeueRequest{
+						QueueName: "mock-queue",
+						Depth:     10,
+						Wait:      durationpb.New(21 * time.Second),
+					})
+
+					Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+					ctrl.Finish()
+				})
+			})
+
 			When("Permission to access the queue is missing", func() {
 				It("Should return an error", func() {
 					ctrl := gomock.NewController(GinkgoT())
@@ -358,6 +488,9 @@ var _ = Describe("Sqs", func() {
 					By("Calling ReceiveMessage with the expected inputs")
 					sqsMock.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{
 						MaxNumberOfMessages: int32(10),
+						AttributeNames: []types.QueueAttributeName{
+							types.QueueAttributeName(types.MessageSystemAttributeNameApproximateReceiveCount),
+						},
 						MessageAttributeNames: []string{
 							string(types.QueueAttributeNameAll),
 						},
@@ -463,5 +596,102 @@ var _ = Describe("Sqs", func() {
 				})
 			})
 		})
+
+		Context("ExtendLease", func() {
+			When("The message is still in flight", func() {
+				It("Should change the visibility timeout to the lease duration", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"test-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+						},
+					}, nil)
+
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Calling SQS with the lease duration rounded up to whole seconds")
+					sqsMock.EXPECT().ChangeMessageVisibility(gomock.Any(), &sqs.ChangeMessageVisibilityInput{
+						QueueUrl:          queueUrl,
+						ReceiptHandle:     aws.String("lease-id"),
+						VisibilityTimeout: 91,
+					}).Times(1).Return(&sqs.ChangeMessageVisibilityOutput{}, nil)
+
+					resp, err := plugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+						QueueName:     "test-queue",
+						LeaseId:       "lease-id",
+						LeaseDuration: durationpb.New(90*time.Second + time.Millisecond),
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(resp.LeaseId).To(Equal("lease-id"))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("The lease duration is longer than SQS allows", func() {
+				It("Should return an invalid argument error", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					plugin := NewWithClient(mock_provider.NewMockAwsResourceResolver(ctrl), mocks_sqs.NewMockSQSAPI(ctrl))
+
+					_, err := plugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+						QueueName:     "test-queue",
+						LeaseId:       "lease-id",
+						LeaseDuration: durationpb.New(13 * time.Hour),
+					})
+
+					Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+					ctrl.Finish()
+				})
+			})
+		})
+
+		Context("Release", func() {
+			When("The receipt handle is no longer valid", func() {
+				It("Should return a not found error", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"test-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+						},
+					}, nil)
+
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Making the message visible immediately")
+					sqsMock.EXPECT().ChangeMessageVisibility(gomock.Any(), &sqs.ChangeMessageVisibilityInput{
+						QueueUrl:          queueUrl,
+						ReceiptHandle:     aws.String("lease-id"),
+						VisibilityTimeout: 0,
+					}).Times(1).Return(nil, &types.ReceiptHandleIsInvalid{})
+
+					_, err := plugin.Release(context.TODO(), &queuepb.QueueReleaseRequest{
+						QueueName: "test-queue",
+						LeaseId:   "lease-id",
+					})
+
+					Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+					ctrl.Finish()
+				})
+			})
+		})
 	})
 })
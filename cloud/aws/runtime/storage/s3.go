@@ -20,14 +20,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nitrictech/nitric/cloud/aws/ifaces/s3iface"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
@@ -130,13 +134,18 @@ func (s *S3StorageService) Write(ctx context.Context, req *storagepb.StorageWrit
 	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Write")
 
 	if b, err := s.getS3BucketName(ctx, req.BucketName); err == nil {
-		contentType := content.DetectContentType(req.Key, req.Body)
+		contentType := req.ContentType
+		if contentType == "" {
+			contentType = content.DetectContentType(req.Key, req.Body)
+		}
 
 		if _, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
-			Bucket:      b,
-			Body:        bytes.NewReader(req.Body),
-			ContentType: &contentType,
-			Key:         aws.String(req.Key),
+			Bucket:       b,
+			Body:         bytes.NewReader(req.Body),
+			ContentType:  &contentType,
+			CacheControl: optionalString(req.CacheControl),
+			Metadata:     req.Metadata,
+			Key:          aws.String(req.Key),
 		}); err != nil {
 			if isS3AccessDeniedErr(err) {
 				return nil, newErr(
@@ -163,6 +172,222 @@ func (s *S3StorageService) Write(ctx context.Context, req *storagepb.StorageWrit
 	return &storagepb.StorageWriteResponse{}, nil
 }
 
+// s3RangeHeader returns the HTTP range header for a byte range of an object, or nil for the entire object
+func s3RangeHeader(offset int64, length int64) *string {
+	switch {
+	case length > 0:
+		return aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	case offset > 0:
+		return aws.String(fmt.Sprintf("bytes=%d-", offset))
+	default:
+		return nil
+	}
+}
+
+// ReadStream streams the contents of a file, or a byte range of a file, from a bucket
+func (s *S3StorageService) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.ReadStream")
+	ctx := stream.Context()
+
+	if req.Offset < 0 || req.Length < 0 {
+		return newErr(codes.InvalidArgument, "offset and length cannot be negative", nil)
+	}
+
+	s3BucketName, err := s.getS3BucketName(ctx, req.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"error finding S3 bucket",
+			err,
+		)
+	}
+
+	resp, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: s3BucketName,
+		Key:    aws.String(req.Key),
+		Range:  s3RangeHeader(req.Offset, req.Length),
+	})
+	if err != nil {
+		if isS3AccessDeniedErr(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to read file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Unknown,
+			"error reading file",
+			err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if err := content.SendChunks(resp.Body, stream); err != nil {
+		return newErr(
+			codes.Unknown,
+			"error streaming file",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// multipartPartSize is the size of the parts streamed writes are uploaded in, S3 requires all but the last part to be at least 5MiB
+const multipartPartSize = 8 * 1024 * 1024
+
+// WriteStream writes a streamed file to a bucket, using a multipart upload for files larger than a single part
+func (s *S3StorageService) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.WriteStream")
+	ctx := stream.Context()
+
+	metadata, body, err := content.NewWriteStreamReader(stream)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "invalid write stream", err)
+	}
+
+	b, err := s.getS3BucketName(ctx, metadata.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"error finding S3 bucket",
+			err,
+		)
+	}
+
+	var reader io.Reader = body
+
+	contentType := metadata.ContentType
+	if contentType == "" {
+		contentType, reader = content.DetectStreamContentType(metadata.Key, body)
+	}
+
+	part := make([]byte, multipartPartSize)
+	n, err := io.ReadFull(reader, part)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return newErr(codes.Unknown, "error receiving file", err)
+	}
+
+	// files that fit in a single part don't need a multipart upload
+	if n < multipartPartSize {
+		if _, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:       b,
+			Body:         bytes.NewReader(part[:n]),
+			ContentType:  &contentType,
+			CacheControl: optionalString(metadata.CacheControl),
+			Metadata:     metadata.Metadata,
+			Key:          aws.String(metadata.Key),
+		}); err != nil {
+			if isS3AccessDeniedErr(err) {
+				return newErr(
+					codes.PermissionDenied,
+					"unable to write file, this may be due to a missing permissions request in your code.",
+					err,
+				)
+			}
+
+			return newErr(
+				codes.Unknown,
+				"error writing file",
+				err,
+			)
+		}
+
+		return stream.SendAndClose(&storagepb.StorageWriteResponse{})
+	}
+
+	upload, err := s.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:       b,
+		ContentType:  &contentType,
+		CacheControl: optionalString(metadata.CacheControl),
+		Metadata:     metadata.Metadata,
+		Key:          aws.String(metadata.Key),
+	})
+	if err != nil {
+		if isS3AccessDeniedErr(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Unknown,
+			"error starting multipart upload",
+			err,
+		)
+	}
+
+	completedParts, err := s.uploadParts(ctx, upload, reader, part[:n])
+	if err != nil {
+		// abort with a new context, the stream context may have been cancelled
+		_, abortErr := s.s3Client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   upload.Bucket,
+			Key:      upload.Key,
+			UploadId: upload.UploadId,
+		})
+
+		return newErr(
+			codes.Unknown,
+			"error uploading file",
+			errors.Join(err, abortErr),
+		)
+	}
+
+	if _, err := s.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   upload.Bucket,
+		Key:      upload.Key,
+		UploadId: upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+	}); err != nil {
+		return newErr(
+			codes.Unknown,
+			"error completing multipart upload",
+			err,
+		)
+	}
+
+	return stream.SendAndClose(&storagepb.StorageWriteResponse{})
+}
+
+// uploadParts uploads the first part followed by the rest of the reader in parts of multipartPartSize
+func (s *S3StorageService) uploadParts(ctx context.Context, upload *s3.CreateMultipartUploadOutput, reader io.Reader, firstPart []byte) ([]types.CompletedPart, error) {
+	completedParts := []types.CompletedPart{}
+	part := firstPart
+
+	for partNumber := int32(1); len(part) > 0; partNumber++ {
+		resp, err := s.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     upload.Bucket,
+			Key:        upload.Key,
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int32(partNumber),
+			Body:       bytes.NewReader(part),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		completedParts = append(completedParts, types.CompletedPart{
+			ETag:       resp.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+
+		part = make([]byte, multipartPartSize)
+		n, err := io.ReadFull(reader, part)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		part = part[:n]
+	}
+
+	return completedParts, nil
+}
+
 // Delete a file from a bucket
 func (s *S3StorageService) Delete(ctx context.Context, req *storagepb.StorageDeleteRequest) (*storagepb.StorageDeleteResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Delete")
@@ -197,6 +422,128 @@ func (s *S3StorageService) Delete(ctx context.Context, req *storagepb.StorageDel
 	return &storagepb.StorageDeleteResponse{}, nil
 }
 
+// copySource returns the URL encoded bucket and key of an object, used to identify the source of a copy
+func copySource(bucket string, key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return url.PathEscape(bucket) + "/" + strings.Join(segments, "/")
+}
+
+// isS3NoSuchKeyErr returns true if the error is S3 reporting that an object doesn't exist
+func isS3NoSuchKeyErr(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchKey"
+}
+
+// Copy a file to another key, in the same or another bucket.
+// S3 copies objects up to 5GB in a single request, along with their content type and metadata.
+func (s *S3StorageService) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Copy")
+
+	source, err := s.getS3BucketName(ctx, req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"error finding source S3 bucket",
+			err,
+		)
+	}
+
+	destination, err := s.getS3BucketName(ctx, req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"error finding destination S3 bucket",
+			err,
+		)
+	}
+
+	// S3 rejects copying an object onto itself without changing it, which leaves the object unchanged anyway
+	if *source == *destination && req.SourceKey == req.DestinationKey {
+		exists, err := s.Exists(ctx, &storagepb.StorageExistsRequest{
+			BucketName: req.SourceBucketName,
+			Key:        req.SourceKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists.Exists {
+			return nil, newErr(
+				codes.NotFound,
+				fmt.Sprintf("file %s not found in bucket %s", req.SourceKey, req.SourceBucketName),
+				nil,
+			)
+		}
+
+		return &storagepb.StorageCopyResponse{}, nil
+	}
+
+	if _, err := s.s3Client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     destination,
+		Key:        aws.String(req.DestinationKey),
+		CopySource: aws.String(copySource(*source, req.SourceKey)),
+	}); err != nil {
+		if isS3AccessDeniedErr(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to copy file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		if isS3NoSuchKeyErr(err) {
+			return nil, newErr(
+				codes.NotFound,
+				fmt.Sprintf("file %s not found in bucket %s", req.SourceKey, req.SourceBucketName),
+				err,
+			)
+		}
+
+		return nil, newErr(
+			codes.Unknown,
+			"error copying file",
+			err,
+		)
+	}
+
+	return &storagepb.StorageCopyResponse{}, nil
+}
+
+// Move a file to another key, in the same or another bucket, by copying it and deleting the original
+func (s *S3StorageService) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	if _, err := s.Copy(ctx, &storagepb.StorageCopyRequest{
+		SourceBucketName:      req.SourceBucketName,
+		SourceKey:             req.SourceKey,
+		DestinationBucketName: req.DestinationBucketName,
+		DestinationKey:        req.DestinationKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	// moving a file onto itself leaves it in place
+	if req.SourceBucketName == req.DestinationBucketName && req.SourceKey == req.DestinationKey {
+		return &storagepb.StorageMoveResponse{}, nil
+	}
+
+	if _, err := s.Delete(ctx, &storagepb.StorageDeleteRequest{
+		BucketName: req.SourceBucketName,
+		Key:        req.SourceKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StorageMoveResponse{}, nil
+}
+
 // PreSignUrl generates a signed URL which can be used to perform direct operations on a file
 // useful for large file uploads/downloads so they can bypass application code and work directly with S3
 func (s *S3StorageService) PreSignUrl(ctx context.Context, req *storagepb.StoragePreSignUrlRequest) (*storagepb.StoragePreSignUrlResponse, error) {
@@ -256,37 +603,75 @@ func (s *S3StorageService) ListBlobs(ctx context.Context, req *storagepb.Storage
 		prefix = &req.Prefix
 	}
 
+	if req.PageSize < 0 {
+		return nil, newErr(codes.InvalidArgument, "page size cannot be negative", nil)
+	}
+
+	var maxKeys *int32 = nil
+	if req.PageSize > 0 {
+		maxKeys = aws.Int32(req.PageSize)
+	}
+
 	if b, err := s.getS3BucketName(ctx, req.BucketName); err == nil {
-		objects, err := s.s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
-			Bucket: b,
-			Prefix: prefix,
-		})
-		if err != nil {
-			if isS3AccessDeniedErr(err) {
+		resp := &storagepb.StorageListBlobsResponse{
+			Blobs:    []*storagepb.Blob{},
+			Prefixes: []string{},
+		}
+
+		token := optionalString(req.PageToken)
+
+		for {
+			objects, err := s.s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket:            b,
+				Prefix:            prefix,
+				Delimiter:         optionalString(req.Delimiter),
+				MaxKeys:           maxKeys,
+				ContinuationToken: token,
+			})
+			if err != nil {
+				if isS3AccessDeniedErr(err) {
+					return nil, newErr(
+						codes.PermissionDenied,
+						"unable to list files, this may be due to a missing permissions request in your code.",
+						err,
+					)
+				}
+
 				return nil, newErr(
-					codes.PermissionDenied,
-					"unable to list files, this may be due to a missing permissions request in your code.",
+					codes.Unknown,
+					"error listing files",
 					err,
 				)
 			}
 
-			return nil, newErr(
-				codes.Unknown,
-				"error listing files",
-				err,
-			)
-		}
+			// content type, cache control and user metadata aren't returned when listing objects, they're only available from Stat
+			for _, o := range objects.Contents {
+				resp.Blobs = append(resp.Blobs, &storagepb.Blob{
+					Key:          *o.Key,
+					Size:         aws.ToInt64(o.Size),
+					Etag:         unquoteETag(o.ETag),
+					LastModified: optionalTimestamp(o.LastModified),
+				})
+			}
 
-		files := make([]*storagepb.Blob, 0, len(objects.Contents))
-		for _, o := range objects.Contents {
-			files = append(files, &storagepb.Blob{
-				Key: *o.Key,
-			})
+			for _, p := range objects.CommonPrefixes {
+				resp.Prefixes = append(resp.Prefixes, aws.ToString(p.Prefix))
+			}
+
+			if !aws.ToBool(objects.IsTruncated) {
+				break
+			}
+
+			// return a single page when paging, otherwise keep listing until every object has been read
+			if maxKeys != nil {
+				resp.NextPageToken = aws.ToString(objects.NextContinuationToken)
+				break
+			}
+
+			token = objects.NextContinuationToken
 		}
 
-		return &storagepb.StorageListBlobsResponse{
-			Blobs: files,
-		}, nil
+		return resp, nil
 	} else {
 		return nil, newErr(
 			codes.NotFound,
@@ -327,6 +712,71 @@ func (s *S3StorageService) Exists(ctx context.Context, req *storagepb.StorageExi
 	}, nil
 }
 
+// Stat returns the attributes of a file in a bucket
+func (s *S3StorageService) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Stat")
+
+	b, err := s.getS3BucketName(ctx, req.BucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding S3 bucket", err)
+	}
+
+	head, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: b,
+		Key:    aws.String(req.Key),
+	})
+	if err != nil {
+		if isS3AccessDeniedErr(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to read file attributes, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("file %s not found in bucket %s", req.Key, req.BucketName), err)
+		}
+
+		return nil, newErr(codes.Unknown, "error reading file attributes", err)
+	}
+
+	return &storagepb.StorageStatResponse{
+		Blob: &storagepb.Blob{
+			Key:          req.Key,
+			Size:         aws.ToInt64(head.ContentLength),
+			Etag:         unquoteETag(head.ETag),
+			LastModified: optionalTimestamp(head.LastModified),
+			ContentType:  aws.ToString(head.ContentType),
+			CacheControl: aws.ToString(head.CacheControl),
+			Metadata:     head.Metadata,
+		},
+	}, nil
+}
+
+// optionalString returns nil for empty strings, so optional S3 parameters are omitted
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return aws.String(s)
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// unquoteETag removes the quotes S3 includes in ETags
+func unquoteETag(etag *string) string {
+	return strings.Trim(aws.ToString(etag), `"`)
+}
+
 // New creates a new default S3 storage plugin
 func New(resolver resource.AwsResourceResolver) (*S3StorageService, error) {
 	awsRegion := env.AWS_REGION.String()
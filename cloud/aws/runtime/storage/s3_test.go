@@ -30,6 +30,9 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	mock_provider "github.com/nitrictech/nitric/cloud/aws/mocks/provider"
@@ -39,6 +42,69 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// mockReadStream collects the chunks sent by ReadStream
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+// mockWriteStream sends the given messages to WriteStream
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+	closed   bool
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(*storagepb.StorageWriteResponse) error {
+	m.closed = true
+	return nil
+}
+
+func newMockWriteStream(bucket string, key string, chunks ...[]byte) *mockWriteStream {
+	requests := []*storagepb.StorageWriteStreamRequest{{
+		Content: &storagepb.StorageWriteStreamRequest_Metadata{
+			Metadata: &storagepb.StorageWriteStreamMetadata{
+				BucketName: bucket,
+				Key:        key,
+			},
+		},
+	}}
+
+	for _, chunk := range chunks {
+		requests = append(requests, &storagepb.StorageWriteStreamRequest{
+			Content: &storagepb.StorageWriteStreamRequest_Chunk{
+				Chunk: chunk,
+			},
+		})
+	}
+
+	return &mockWriteStream{requests: requests}
+}
+
 var _ = Describe("S3", func() {
 	When("Write", func() {
 		When("Given the S3 backend is available", func() {
@@ -331,7 +397,9 @@ var _ = Describe("S3", func() {
 						Prefix: aws.String("test/"),
 					}).Return(&s3.ListObjectsV2Output{
 						Contents: []types.Object{{
-							Key: aws.String("test/test"),
+							Key:  aws.String("test/test"),
+							Size: aws.Int64(5),
+							ETag: aws.String(`"abc123"`),
 						}},
 					}, nil)
 
@@ -348,6 +416,86 @@ var _ = Describe("S3", func() {
 
 					By("having the returned keys")
 					Expect(resp.Blobs[0].Key).To(Equal("test/test"))
+
+					By("having the returned sizes and etags")
+					Expect(resp.Blobs[0].Size).To(Equal(int64(5)))
+					Expect(resp.Blobs[0].Etag).To(Equal("abc123"))
+				})
+
+				It("should return a single page of files and prefixes", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("s3 returning a truncated page")
+					mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+						Bucket:            aws.String("test-bucket-aaa111"),
+						Delimiter:         aws.String("/"),
+						MaxKeys:           aws.Int32(2),
+						ContinuationToken: aws.String("page-1"),
+					}).Return(&s3.ListObjectsV2Output{
+						Contents: []types.Object{{
+							Key: aws.String("test"),
+						}},
+						CommonPrefixes: []types.CommonPrefix{{
+							Prefix: aws.String("test/"),
+						}},
+						IsTruncated:           aws.Bool(true),
+						NextContinuationToken: aws.String("page-2"),
+					}, nil)
+
+					resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName: "test-bucket",
+						Delimiter:  "/",
+						PageSize:   2,
+						PageToken:  "page-1",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the files and prefixes from s3")
+					Expect(resp.Blobs).To(HaveLen(1))
+					Expect(resp.Prefixes).To(Equal([]string{"test/"}))
+
+					By("returning the token for the next page")
+					Expect(resp.NextPageToken).To(Equal("page-2"))
+				})
+
+				It("should read every page when not paging", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("s3 returning two pages")
+					gomock.InOrder(
+						mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+							Bucket: aws.String("test-bucket-aaa111"),
+						}).Return(&s3.ListObjectsV2Output{
+							Contents:              []types.Object{{Key: aws.String("one")}},
+							IsTruncated:           aws.Bool(true),
+							NextContinuationToken: aws.String("page-2"),
+						}, nil),
+						mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+							Bucket:            aws.String("test-bucket-aaa111"),
+							ContinuationToken: aws.String("page-2"),
+						}).Return(&s3.ListObjectsV2Output{
+							Contents: []types.Object{{Key: aws.String("two")}},
+						}, nil),
+					)
+
+					resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName: "test-bucket",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the files from every page")
+					Expect(resp.Blobs).To(HaveLen(2))
+					Expect(resp.NextPageToken).To(BeEmpty())
 				})
 			})
 		})
@@ -416,4 +564,361 @@ var _ = Describe("S3", func() {
 			})
 		})
 	})
+
+	When("Stat", func() {
+		When("The bucket exists", func() {
+			When("The s3 backend is available", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				When("the file exists", func() {
+					It("should return the file attributes", func() {
+						lastModified := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+						By("the bucket existing")
+						mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+							"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+						}, nil)
+
+						By("the file existing")
+						mockStorageClient.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+							Bucket: aws.String("test-bucket-aaa111"),
+							Key:    aws.String("test-file"),
+						}).Return(&s3.HeadObjectOutput{
+							ContentLength: aws.Int64(12),
+							ETag:          aws.String(`"abc123"`),
+							LastModified:  &lastModified,
+							ContentType:   aws.String("text/plain"),
+							CacheControl:  aws.String("max-age=60"),
+							Metadata:      map[string]string{"owner": "test"},
+						}, nil)
+
+						resp, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+							BucketName: "test-bucket",
+							Key:        "test-file",
+						})
+
+						By("not returning an error")
+						Expect(err).ShouldNot(HaveOccurred())
+
+						By("returning the file attributes")
+						Expect(resp.Blob.Key).To(Equal("test-file"))
+						Expect(resp.Blob.Size).To(Equal(int64(12)))
+						Expect(resp.Blob.Etag).To(Equal("abc123"))
+						Expect(resp.Blob.LastModified.AsTime()).To(Equal(lastModified))
+						Expect(resp.Blob.ContentType).To(Equal("text/plain"))
+						Expect(resp.Blob.CacheControl).To(Equal("max-age=60"))
+						Expect(resp.Blob.Metadata).To(Equal(map[string]string{"owner": "test"}))
+					})
+				})
+
+				When("the file does not exist", func() {
+					It("should return a not found error", func() {
+						By("the bucket existing")
+						mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+							"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+						}, nil)
+
+						By("the file not existing")
+						mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(nil, &types.NotFound{})
+
+						_, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+							BucketName: "test-bucket",
+							Key:        "test-file",
+						})
+
+						By("returning a not found error")
+						Expect(status.Code(err)).To(Equal(codes.NotFound))
+					})
+				})
+			})
+		})
+	})
+
+	When("Copy", func() {
+		When("The buckets exist", func() {
+			When("The s3 backend is available", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				It("should copy the object between buckets", func() {
+					By("the buckets existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"source-bucket":      {ARN: "arn:aws:s3:::source-bucket-aaa111"},
+						"destination-bucket": {ARN: "arn:aws:s3:::destination-bucket-aaa111"},
+					}, nil).Times(2)
+
+					By("s3 copying the object")
+					mockStorageClient.EXPECT().CopyObject(gomock.Any(), &s3.CopyObjectInput{
+						Bucket:     aws.String("destination-bucket-aaa111"),
+						Key:        aws.String("copied file.txt"),
+						CopySource: aws.String("source-bucket-aaa111/test/test%20file.txt"),
+					}).Return(&s3.CopyObjectOutput{}, nil)
+
+					_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+						SourceBucketName:      "source-bucket",
+						SourceKey:             "test/test file.txt",
+						DestinationBucketName: "destination-bucket",
+						DestinationKey:        "copied file.txt",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+
+				It("should return a not found error when the source object doesn't exist", func() {
+					By("the buckets existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil).Times(2)
+
+					By("the source object not existing")
+					mockStorageClient.EXPECT().CopyObject(gomock.Any(), gomock.Any()).Return(nil, &types.NoSuchKey{})
+
+					_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+						SourceBucketName:      "test-bucket",
+						SourceKey:             "missing-file",
+						DestinationBucketName: "test-bucket",
+						DestinationKey:        "copied-file",
+					})
+
+					By("returning a not found error")
+					Expect(status.Code(err)).To(Equal(codes.NotFound))
+				})
+			})
+		})
+	})
+
+	When("Move", func() {
+		When("The buckets exist", func() {
+			When("The s3 backend is available", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				It("should copy the object and delete the original", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil).Times(3)
+
+					By("s3 copying the object, then deleting the original")
+					gomock.InOrder(
+						mockStorageClient.EXPECT().CopyObject(gomock.Any(), &s3.CopyObjectInput{
+							Bucket:     aws.String("test-bucket-aaa111"),
+							Key:        aws.String("moved-file"),
+							CopySource: aws.String("test-bucket-aaa111/test-file"),
+						}).Return(&s3.CopyObjectOutput{}, nil),
+						mockStorageClient.EXPECT().DeleteObject(gomock.Any(), &s3.DeleteObjectInput{
+							Bucket: aws.String("test-bucket-aaa111"),
+							Key:    aws.String("test-file"),
+						}).Return(&s3.DeleteObjectOutput{}, nil),
+					)
+
+					_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+						SourceBucketName:      "test-bucket",
+						SourceKey:             "test-file",
+						DestinationBucketName: "test-bucket",
+						DestinationKey:        "moved-file",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+
+				It("should not delete the original when the copy fails", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil).Times(2)
+
+					By("s3 failing to copy the object")
+					mockStorageClient.EXPECT().CopyObject(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("mock-error"))
+
+					_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+						SourceBucketName:      "test-bucket",
+						SourceKey:             "test-file",
+						DestinationBucketName: "test-bucket",
+						DestinationKey:        "moved-file",
+					})
+
+					By("returning an error")
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	When("ReadStream", func() {
+		When("Reading a byte range of an existing object", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("Should stream the requested range of the object", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket"},
+				}, nil)
+
+				By("requesting the range from S3")
+				mockStorageClient.EXPECT().GetObject(gomock.Any(), &s3.GetObjectInput{
+					Bucket: aws.String("test-bucket"),
+					Key:    aws.String("test-key"),
+					Range:  aws.String("bytes=2-5"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewReader([]byte("st d"))),
+				}, nil)
+
+				stream := &mockReadStream{}
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+					Offset:     2,
+					Length:     4,
+				}, stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Sending the range")
+				Expect(bytes.Join(stream.chunks, nil)).To(Equal([]byte("st d")))
+			})
+		})
+
+		When("Reading with a negative offset", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("Should return an error", func() {
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+					Offset:     -1,
+				}, &mockReadStream{})
+
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	When("WriteStream", func() {
+		When("Writing an object smaller than a single part", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("Should put the object in a single request", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"my-bucket": {ARN: "arn:aws:s3:::my-bucket"},
+				}, nil)
+
+				By("writing the item")
+				var written []byte
+				mockStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+					written, _ = io.ReadAll(input.Body)
+					return &s3.PutObjectOutput{}, nil
+				})
+
+				stream := newMockWriteStream("my-bucket", "test-item.txt", []byte("Te"), []byte("st"))
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.closed).To(BeTrue())
+
+				By("Writing the chunks in order")
+				Expect(written).To(Equal([]byte("Test")))
+			})
+		})
+
+		When("Writing an object larger than a single part", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("Should use a multipart upload", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"my-bucket": {ARN: "arn:aws:s3:::my-bucket"},
+				}, nil)
+
+				mockStorageClient.EXPECT().CreateMultipartUpload(gomock.Any(), gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+					Bucket:   aws.String("my-bucket"),
+					Key:      aws.String("large-item"),
+					UploadId: aws.String("upload-id"),
+				}, nil)
+
+				By("uploading each part")
+				partSizes := []int{}
+				mockStorageClient.EXPECT().UploadPart(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, input *s3.UploadPartInput, opts ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+					part, _ := io.ReadAll(input.Body)
+					partSizes = append(partSizes, len(part))
+					return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", *input.PartNumber))}, nil
+				})
+
+				By("completing the upload with the uploaded parts")
+				mockStorageClient.EXPECT().CompleteMultipartUpload(gomock.Any(), &s3.CompleteMultipartUploadInput{
+					Bucket:   aws.String("my-bucket"),
+					Key:      aws.String("large-item"),
+					UploadId: aws.String("upload-id"),
+					MultipartUpload: &types.CompletedMultipartUpload{
+						Parts: []types.CompletedPart{
+							{ETag: aws.String("etag-1"), PartNumber: aws.Int32(1)},
+							{ETag: aws.String("etag-2"), PartNumber: aws.Int32(2)},
+						},
+					},
+				}).Return(&s3.CompleteMultipartUploadOutput{}, nil)
+
+				// 8MiB in 1MiB chunks followed by a final 10 bytes
+				chunks := [][]byte{}
+				for i := 0; i < 8; i++ {
+					chunks = append(chunks, bytes.Repeat([]byte("a"), 1024*1024))
+				}
+				chunks = append(chunks, []byte("0123456789"))
+
+				stream := newMockWriteStream("my-bucket", "large-item", chunks...)
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(partSizes).To(Equal([]int{8 * 1024 * 1024, 10}))
+			})
+		})
+
+		When("The stream doesn't start with metadata", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("Should return an error", func() {
+				stream := &mockWriteStream{requests: []*storagepb.StorageWriteStreamRequest{{
+					Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("Test")},
+				}}}
+
+				err := storagePlugin.WriteStream(stream)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
 })
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,11 +40,18 @@ import (
 	"github.com/nitrictech/nitric/cloud/aws/ifaces/snsiface"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/resource"
+	"github.com/nitrictech/nitric/core/pkg/decorators/topic"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 )
 
+// maxBatchSize is the most messages SNS accepts in a single PublishBatch request
+const maxBatchSize = 10
+
+// maxAttributes is the most attributes a message can be published with, SNS allows 10 and one is used for trace propagation
+const maxAttributes = 9
+
 type SnsEventService struct {
 	client    snsiface.SNSAPI
 	sfnClient sfniface.SFNAPI
@@ -68,13 +76,31 @@ func (s *SnsEventService) getStateMachines(ctx context.Context) (map[string]reso
 	return s.resolver.GetResources(ctx, resource.AwsResource_StateMachine)
 }
 
-func (s *SnsEventService) publish(ctx context.Context, topic string, message string) error {
+// messageAttributes - converts message attributes to SNS message attributes
+func messageAttributes(attributes map[string]string) map[string]types.MessageAttributeValue {
+	attrs := map[string]types.MessageAttributeValue{}
+	for k, v := range attributes {
+		attrs[k] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	}
+	return attrs
+}
+
+// delayedAttributes - converts message attributes to the input format of the SNS publish step of a delay state machine
+func delayedAttributes(attributes map[string]string) map[string]map[string]string {
+	attrs := map[string]map[string]string{}
+	for k, v := range attributes {
+		attrs[k] = map[string]string{"DataType": "String", "StringValue": v}
+	}
+	return attrs
+}
+
+func (s *SnsEventService) publish(ctx context.Context, req *topicpb.TopicPublishRequest, message string) error {
 	topics, err := s.getTopics(ctx)
 	if err != nil {
 		return fmt.Errorf("error finding topics: %w", err)
 	}
 
-	snsTopic, ok := topics[topic]
+	snsTopic, ok := topics[req.TopicName]
 
 	if !ok {
 		return fmt.Errorf("could not resolve topic ARN from topic name")
@@ -83,9 +109,9 @@ func (s *SnsEventService) publish(ctx context.Context, topic string, message str
 	mc := propagation.MapCarrier{}
 	xray.Propagator{}.Inject(ctx, mc)
 
-	attrs := map[string]types.MessageAttributeValue{}
-	for k, v := range mc {
-		attrs[k] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	attrs := messageAttributes(req.Attributes)
+	for k, v := range messageAttributes(mc) {
+		attrs[k] = v
 	}
 
 	publishInput := &sns.PublishInput{
@@ -97,12 +123,17 @@ func (s *SnsEventService) publish(ctx context.Context, topic string, message str
 		MessageAttributes: attrs,
 	}
 
+	// ordering keys map to FIFO message groups
+	if req.OrderingKey != "" {
+		publishInput.MessageGroupId = aws.String(req.OrderingKey)
+	}
+
 	_, err = s.client.Publish(ctx, publishInput)
 
 	return err
 }
 
-func (s *SnsEventService) publishDelayed(ctx context.Context, topic string, delay time.Duration, message string) error {
+func (s *SnsEventService) publishDelayed(ctx context.Context, topic string, delay time.Duration, message string, attributes map[string]string) error {
 	stepFunctions, err := s.getStateMachines(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting state machines: %w", err)
@@ -117,8 +148,9 @@ func (s *SnsEventService) publishDelayed(ctx context.Context, topic string, dela
 	xray.Propagator{}.Inject(ctx, mc)
 
 	input, err := json.Marshal(map[string]interface{}{
-		"seconds": int(delay / time.Second),
-		"message": message,
+		"seconds":    int(delay / time.Second),
+		"message":    message,
+		"attributes": delayedAttributes(attributes),
 	})
 	if err != nil {
 		return err
@@ -147,10 +179,18 @@ func (s *SnsEventService) Publish(ctx context.Context, req *topicpb.TopicPublish
 	}
 	message := base64.StdEncoding.EncodeToString(messageBytes)
 
+	if err := topic.ValidateAttributes(req.Attributes, maxAttributes); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid attributes", err)
+	}
+
+	if err := topic.ValidateOrderingKey(req.OrderingKey, req.Delay); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid ordering key", err)
+	}
+
 	if req.Delay != nil && req.Delay.AsDuration() > 0 {
-		err = s.publishDelayed(ctx, req.TopicName, req.Delay.AsDuration(), message)
+		err = s.publishDelayed(ctx, req.TopicName, req.Delay.AsDuration(), message, req.Attributes)
 	} else {
-		err = s.publish(ctx, req.TopicName, message)
+		err = s.publish(ctx, req, message)
 	}
 
 	if err != nil {
@@ -168,6 +208,106 @@ func (s *SnsEventService) Publish(ctx context.Context, req *topicpb.TopicPublish
 	return &topicpb.TopicPublishResponse{}, nil
 }
 
+// PublishBatch publishes multiple messages to a given topic, in batches of at most maxBatchSize
+func (s *SnsEventService) PublishBatch(ctx context.Context, req *topicpb.TopicPublishBatchRequest) (*topicpb.TopicPublishBatchResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("SnsEventService.PublishBatch")
+
+	topics, err := s.getTopics(ctx)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error finding topics", err)
+	}
+
+	snsTopic, ok := topics[req.TopicName]
+	if !ok {
+		return nil, newErr(codes.NotFound, "could not resolve topic ARN from topic name", nil)
+	}
+
+	mc := propagation.MapCarrier{}
+	xray.Propagator{}.Inject(ctx, mc)
+
+	requestIdMap := map[string]*topicpb.TopicBatchMessage{}
+	failedMessages := []*topicpb.FailedPublishMessage{}
+	entries := []types.PublishBatchRequestEntry{}
+
+	for i, message := range req.Messages {
+		if err := topic.ValidateAttributes(message.Attributes, maxAttributes); err != nil {
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: message,
+				Details: err.Error(),
+			})
+			continue
+		}
+
+		messageBytes, err := proto.Marshal(message.Message)
+		if err != nil {
+			return nil, newErr(
+				codes.Unknown,
+				fmt.Sprintf("unable to serialize message. %s", help.BugInNitricHelpText()),
+				err,
+			)
+		}
+
+		// ids only need to be unique within a batch
+		id := strconv.Itoa(i)
+		requestIdMap[id] = message
+
+		attrs := messageAttributes(message.Attributes)
+		for k, v := range messageAttributes(mc) {
+			attrs[k] = v
+		}
+
+		entry := types.PublishBatchRequestEntry{
+			Id:                aws.String(id),
+			Message:           aws.String(base64.StdEncoding.EncodeToString(messageBytes)),
+			MessageAttributes: attrs,
+		}
+
+		if message.OrderingKey != "" {
+			entry.MessageGroupId = aws.String(message.OrderingKey)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for start := 0; start < len(entries); start += maxBatchSize {
+		batch := entries[start:min(start+maxBatchSize, len(entries))]
+
+		out, err := s.client.PublishBatch(ctx, &sns.PublishBatchInput{
+			TopicArn:                   aws.String(snsTopic.ARN),
+			PublishBatchRequestEntries: batch,
+		})
+		if err != nil {
+			if isSNSAccessDeniedErr(err) {
+				return nil, newErr(
+					codes.PermissionDenied,
+					"unable to publish to topic, this may be due to a missing permissions request in your code.",
+					err,
+				)
+			}
+
+			// the whole batch failed, so each of its messages is reported as failed
+			for _, entry := range batch {
+				failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+					Message: requestIdMap[*entry.Id],
+					Details: err.Error(),
+				})
+			}
+			continue
+		}
+
+		for _, failed := range out.Failed {
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: requestIdMap[aws.ToString(failed.Id)],
+				Details: aws.ToString(failed.Message),
+			})
+		}
+	}
+
+	return &topicpb.TopicPublishBatchResponse{
+		FailedMessages: failedMessages,
+	}, nil
+}
+
 // Create new SNS event service plugin
 func New(resolver resource.AwsResourceResolver) (*SnsEventService, error) {
 	awsRegion := env.AWS_REGION.String()
@@ -16,6 +16,7 @@ package topic_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -82,6 +85,73 @@ var _ = Describe("Sns", func() {
 			})
 		})
 
+		When("Publishing with attributes and an ordering key", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			message := &eventpb.TopicMessage{
+				Content: &eventpb.TopicMessage_StructPayload{
+					StructPayload: payload,
+				},
+			}
+
+			data, _ := proto.Marshal(message)
+
+			It("Should publish the attributes and a message group", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test"},
+				}, nil)
+
+				By("Publishing the message to the topic")
+				snsMock.EXPECT().Publish(gomock.Any(), &sns.PublishInput{
+					MessageAttributes: map[string]types.MessageAttributeValue{
+						"region": {DataType: aws.String("String"), StringValue: aws.String("ap-southeast-2")},
+					},
+					TopicArn:       aws.String("arn:test"),
+					Message:        aws.String(base64.StdEncoding.EncodeToString(data)),
+					MessageGroupId: aws.String("customer-1"),
+				})
+
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName:   "test",
+					Message:     message,
+					Attributes:  map[string]string{"region": "ap-southeast-2"},
+					OrderingKey: "customer-1",
+				})
+
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("Publishing with a reserved attribute", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			It("Should return an invalid argument error", func() {
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName: "test",
+					Message: &eventpb.TopicMessage{
+						Content: &eventpb.TopicMessage_StructPayload{
+							StructPayload: payload,
+						},
+					},
+					Attributes: map[string]string{"x-nitric-topic": "other"},
+				})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
 		When("Publishing to a non-existent topic", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
@@ -141,6 +211,71 @@ var _ = Describe("Sns", func() {
 		})
 	})
 
+	Context("PublishBatch", func() {
+		When("Publishing more messages than fit in a single batch", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			messages := []*eventpb.TopicBatchMessage{}
+			for i := 0; i < 11; i++ {
+				messages = append(messages, &eventpb.TopicBatchMessage{
+					Message: &eventpb.TopicMessage{
+						Content: &eventpb.TopicMessage_StructPayload{
+							StructPayload: payload,
+						},
+					},
+				})
+			}
+
+			invalid := &eventpb.TopicBatchMessage{
+				Message:    messages[0].Message,
+				Attributes: map[string]string{"x-nitric-topic": "other"},
+			}
+
+			It("Should publish in batches and return the failed messages", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test"},
+				}, nil)
+
+				By("Publishing the messages in two batches")
+				gomock.InOrder(
+					snsMock.EXPECT().PublishBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sns.PublishBatchInput, opts ...func(*sns.Options)) (*sns.PublishBatchOutput, error) {
+						Expect(input.PublishBatchRequestEntries).To(HaveLen(10))
+
+						return &sns.PublishBatchOutput{
+							Failed: []types.BatchResultErrorEntry{
+								{Id: input.PublishBatchRequestEntries[0].Id, Message: aws.String("throttled")},
+							},
+						}, nil
+					}),
+					snsMock.EXPECT().PublishBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sns.PublishBatchInput, opts ...func(*sns.Options)) (*sns.PublishBatchOutput, error) {
+						Expect(input.PublishBatchRequestEntries).To(HaveLen(1))
+
+						return &sns.PublishBatchOutput{}, nil
+					}),
+				)
+
+				resp, err := eventsClient.PublishBatch(context.TODO(), &eventpb.TopicPublishBatchRequest{
+					TopicName: "test",
+					Messages:  append([]*eventpb.TopicBatchMessage{invalid}, messages...),
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp.FailedMessages).To(HaveLen(2))
+				Expect(resp.FailedMessages[0].Message).To(Equal(invalid))
+				Expect(resp.FailedMessages[1].Message).To(Equal(messages[0]))
+				Expect(resp.FailedMessages[1].Details).To(Equal("throttled"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
 	Context("Delayed Publish", func() {
 		When("Publishing to an available topic", func() {
 			ctrl := gomock.NewController(GinkgoT())
@@ -166,8 +301,9 @@ var _ = Describe("Sns", func() {
 				}, nil)
 
 				input, _ := json.Marshal(map[string]interface{}{
-					"seconds": 1,
-					"message": stringData,
+					"seconds":    1,
+					"message":    stringData,
+					"attributes": map[string]interface{}{},
 				})
 
 				By("Publishing the message to the topic")
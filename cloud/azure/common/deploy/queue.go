@@ -0,0 +1,137 @@
+// Copyright Nitric Pty Ltd.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+
+package deploy
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+)
+
+// Azure Storage Queues don't support dead lettering, so a queue's dead letter config is deployed as queue metadata
+// and applied by the runtime when messages are dequeued.
+const (
+	DeadLetterQueueMetadataKey = "nitric_dead_letter_queue"
+	MaxReceivesMetadataKey     = "nitric_max_receives"
+)
+
+// QueueMetadata - Returns the metadata for a queue, including its dead letter config if it has one
+func QueueMetadata(name string, config *deploymentspb.Queue) (map[string]string, error) {
+	deadLetter := config.GetDeadLetter()
+	if deadLetter == nil {
+		return map[string]string{}, nil
+	}
+
+	if deadLetter.MaxReceives < 1 {
+		return nil, fmt.Errorf("queue %s max receives must be at least 1, got %d", name, deadLetter.MaxReceives)
+	}
+
+	if deadLetter.QueueName == "" {
+		return nil, fmt.Errorf("queue %s dead letter queue name cannot be empty", name)
+	}
+
+	return map[string]string{
+		DeadLetterQueueMetadataKey: deadLetter.QueueName,
+		MaxReceivesMetadataKey:     strconv.Itoa(int(deadLetter.MaxReceives)),
+	}, nil
+}
+
+type principalQueue struct {
+	principalType resourcespb.ResourceType
+	principalName string
+	queue         string
+}
+
+// DeadLetterGrants - Tracks the dead letter queues principals need to enqueue to.
+// Dead letters are moved by the runtime of a principal dequeuing from a queue, so it must also be able to enqueue to the dead letter queue.
+type DeadLetterGrants struct {
+	deadLetterQueues map[string]string
+	principals       map[principalQueue]*deploymentspb.Resource
+	granted          map[principalQueue]bool
+	order            []principalQueue
+}
+
+// AddQueue - Records the dead letter queue of a queue, if it has one
+func (g *DeadLetterGrants) AddQueue(name string, config *deploymentspb.Queue) {
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		g.deadLetterQueues[name] = deadLetter.QueueName
+	}
+}
+
+// AddPolicy - Records the dead letter queues the principals of a policy need to enqueue to, and the queues they can already enqueue to
+func (g *DeadLetterGrants) AddPolicy(policy *deploymentspb.Policy) {
+	dequeues := slices.Contains(policy.Actions, resourcespb.Action_QueueDequeue)
+	enqueues := slices.Contains(policy.Actions, resourcespb.Action_QueueEnqueue)
+
+	for _, resource := range policy.Resources {
+		if resource.Id.Type != resourcespb.ResourceType_Queue {
+			continue
+		}
+
+		for _, principal := range policy.Principals {
+			if enqueues {
+				g.granted[principalQueue{principalType: principal.Id.Type, principalName: principal.Id.Name, queue: resource.Id.Name}] = true
+			}
+
+			deadLetterQueue, ok := g.deadLetterQueues[resource.Id.Name]
+			if !dequeues || !ok {
+				continue
+			}
+
+			key := principalQueue{principalType: principal.Id.Type, principalName: principal.Id.Name, queue: deadLetterQueue}
+			if _, ok := g.principals[key]; !ok {
+				g.principals[key] = principal
+				g.order = append(g.order, key)
+			}
+		}
+	}
+}
+
+// Policies - Returns the policies allowing principals to enqueue to dead letter queues, excluding those already granted
+func (g *DeadLetterGrants) Policies() []*deploymentspb.Policy {
+	policies := []*deploymentspb.Policy{}
+
+	for _, key := range g.order {
+		if g.granted[key] {
+			continue
+		}
+
+		policies = append(policies, &deploymentspb.Policy{
+			Principals: []*deploymentspb.Resource{g.principals[key]},
+			Actions:    []resourcespb.Action{resourcespb.Action_QueueEnqueue},
+			Resources: []*deploymentspb.Resource{{
+				Id: &resourcespb.ResourceIdentifier{
+					Name: key.queue,
+					Type: resourcespb.ResourceType_Queue,
+				},
+			}},
+		})
+	}
+
+	return policies
+}
+
+func NewDeadLetterGrants() *DeadLetterGrants {
+	return &DeadLetterGrants{
+		deadLetterQueues: map[string]string{},
+		principals:       map[principalQueue]*deploymentspb.Resource{},
+		granted:          map[principalQueue]bool{},
+		order:            []principalQueue{},
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/cloud/azure/common/deploy"
+	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+)
+
+func resource(name string, resourceType resourcespb.ResourceType) *deploymentspb.Resource {
+	return &deploymentspb.Resource{
+		Id: &resourcespb.ResourceIdentifier{
+			Name: name,
+			Type: resourceType,
+		},
+	}
+}
+
+func queuePolicy(principal string, queue string, actions ...resourcespb.Action) *deploymentspb.Policy {
+	return &deploymentspb.Policy{
+		Principals: []*deploymentspb.Resource{resource(principal, resourcespb.ResourceType_Service)},
+		Actions:    actions,
+		Resources:  []*deploymentspb.Resource{resource(queue, resourcespb.ResourceType_Queue)},
+	}
+}
+
+var _ = Describe("Queue", func() {
+	Context("QueueMetadata", func() {
+		When("The queue has no dead letter queue", func() {
+			It("should return empty metadata", func() {
+				metadata, err := deploy.QueueMetadata("orders", &deploymentspb.Queue{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metadata).To(BeEmpty())
+			})
+		})
+
+		When("The queue has a dead letter queue", func() {
+			It("should return the dead letter config as metadata", func() {
+				metadata, err := deploy.QueueMetadata("orders", &deploymentspb.Queue{
+					DeadLetter: &deploymentspb.QueueDeadLetter{MaxReceives: 5, QueueName: "orders-dead"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metadata).To(Equal(map[string]string{
+					deploy.DeadLetterQueueMetadataKey: "orders-dead",
+					deploy.MaxReceivesMetadataKey:     "5",
+				}))
+			})
+		})
+
+		When("The max receives is not positive", func() {
+			It("should return an error", func() {
+				_, err := deploy.QueueMetadata("orders", &deploymentspb.Queue{
+					DeadLetter: &deploymentspb.QueueDeadLetter{MaxReceives: 0, QueueName: "orders-dead"},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("DeadLetterGrants", func() {
+		var grants *deploy.DeadLetterGrants
+
+		BeforeEach(func() {
+			grants = deploy.NewDeadLetterGrants()
+			grants.AddQueue("orders", &deploymentspb.Queue{
+				DeadLetter: &deploymentspb.QueueDeadLetter{MaxReceives: 5, QueueName: "orders-dead"},
+			})
+			grants.AddQueue("orders-dead", &deploymentspb.Queue{})
+		})
+
+		When("A principal dequeues from a queue with a dead letter queue", func() {
+			It("should allow it to enqueue to the dead letter queue", func() {
+				grants.AddPolicy(queuePolicy("worker", "orders", resourcespb.Action_QueueDequeue))
+
+				policies := grants.Policies()
+				Expect(policies).To(HaveLen(1))
+				Expect(policies[0].Principals[0].Id.Name).To(Equal("worker"))
+				Expect(policies[0].Actions).To(Equal([]resourcespb.Action{resourcespb.Action_QueueEnqueue}))
+				Expect(policies[0].Resources[0].Id.Name).To(Equal("orders-dead"))
+			})
+		})
+
+		When("The principal can already enqueue to the dead letter queue", func() {
+			It("should not return a policy", func() {
+				grants.AddPolicy(queuePolicy("worker", "orders", resourcespb.Action_QueueDequeue))
+				grants.AddPolicy(queuePolicy("worker", "orders-dead", resourcespb.Action_QueueEnqueue))
+
+				Expect(grants.Policies()).To(BeEmpty())
+			})
+		})
+
+		When("A principal only enqueues to a queue with a dead letter queue", func() {
+			It("should not return a policy", func() {
+				grants.AddPolicy(queuePolicy("api", "orders", resourcespb.Action_QueueEnqueue))
+
+				Expect(grants.Policies()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -25,12 +25,17 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// eventTypeToStorageEventType returns the Event Grid event types for a blob event type.
+// Metadata updates are not supported, blob storage doesn't publish an event for them.
 func eventTypeToStorageEventType(eventType *storagepb.BlobEventType) []string {
 	switch *eventType {
 	case storagepb.BlobEventType_Created:
 		return []string{"Microsoft.Storage.BlobCreated"}
 	case storagepb.BlobEventType_Deleted:
 		return []string{"Microsoft.Storage.BlobDeleted"}
+	case storagepb.BlobEventType_Restored:
+		// only rehydrations from the archive tier are delivered to listeners, other tier changes are acknowledged and dropped
+		return []string{"Microsoft.Storage.BlobTierChanged"}
 	default:
 		return []string{}
 	}
@@ -52,6 +57,11 @@ func (p *NitricAzurePulumiProvider) newAzureBucketNotification(ctx *pulumi.Conte
 		return fmt.Errorf("target bucket %s not found", bucketName)
 	}
 
+	eventTypes := eventTypeToStorageEventType(&config.Config.BlobEventType)
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("blob event type %s is not supported by blob storage events", config.Config.BlobEventType)
+	}
+
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent), pulumi.DependsOn([]pulumi.Resource{target.App, bucket})}
 
 	hostUrl, err := target.HostUrl()
@@ -74,7 +84,7 @@ func (p *NitricAzurePulumiProvider) newAzureBucketNotification(ctx *pulumi.Conte
 		},
 		Filter: eventgrid.EventSubscriptionFilterArgs{
 			SubjectBeginsWith:  pulumi.Sprintf("/blobServices/default/containers/%s/blobs/%s", bucketName, removeWildcard(config.Config.KeyPrefixFilter)),
-			IncludedEventTypes: pulumi.ToStringArray(eventTypeToStorageEventType(&config.Config.BlobEventType)),
+			IncludedEventTypes: pulumi.ToStringArray(eventTypes),
 		},
 	}, opts...)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	_ "embed"
 
 	"github.com/nitrictech/nitric/cloud/azure/common"
+	azuredeploy "github.com/nitrictech/nitric/cloud/azure/common/deploy"
 	"github.com/nitrictech/nitric/cloud/common/deploy"
 	"github.com/nitrictech/nitric/cloud/common/deploy/provider"
 	"github.com/nitrictech/nitric/cloud/common/deploy/pulumix"
@@ -79,7 +80,8 @@ type NitricAzurePulumiProvider struct {
 	Buckets               map[string]*storage.BlobContainer
 	UserDelegationKeyRole *authorization.RoleDefinition
 
-	Queues map[string]*storage.Queue
+	Queues           map[string]*storage.Queue
+	DeadLetterGrants *azuredeploy.DeadLetterGrants
 
 	Principals map[resourcespb.ResourceType]map[string]*ServicePrincipal
 
@@ -427,6 +429,13 @@ func (a *NitricAzurePulumiProvider) Pre(ctx *pulumi.Context, nitricResources []*
 }
 
 func (a *NitricAzurePulumiProvider) Post(ctx *pulumi.Context) error {
+	for i, policy := range a.DeadLetterGrants.Policies() {
+		err := a.Policy(ctx, nil, fmt.Sprintf("deadletter-%d", i), policy)
+		if err != nil {
+			return errors.WithMessage(err, "dead letter queue policy")
+		}
+	}
+
 	if len(a.WebsiteContainers) > 0 {
 		err := a.deployCDN(ctx)
 		if err != nil {
@@ -496,6 +505,7 @@ func NewNitricAzurePulumiProvider() *NitricAzurePulumiProvider {
 		HttpProxies:            make(map[string]ApiResources),
 		Buckets:                make(map[string]*storage.BlobContainer),
 		Queues:                 make(map[string]*storage.Queue),
+		DeadLetterGrants:       azuredeploy.NewDeadLetterGrants(),
 		ContainerApps:          make(map[string]*ContainerApp),
 		Topics:                 make(map[string]*eventgrid.Topic),
 		SqlMigrations:          make(map[string]*containerinstance.ContainerGroup),
@@ -138,6 +138,8 @@ func (p *NitricAzurePulumiProvider) scopeFromResource(resource *deploymentspb.Re
 func (p *NitricAzurePulumiProvider) Policy(ctx *pulumi.Context, parent pulumi.Resource, name string, policy *deploymentspb.Policy) error {
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
 
+	p.DeadLetterGrants.AddPolicy(policy)
+
 	for _, resource := range policy.Resources {
 		for _, principal := range policy.Principals {
 			// The roles we need to assign
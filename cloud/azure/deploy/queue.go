@@ -1,6 +1,9 @@
 package deploy
 
 import (
+	"fmt"
+
+	azuredeploy "github.com/nitrictech/nitric/cloud/azure/common/deploy"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 	"github.com/pulumi/pulumi-azure-native-sdk/storage"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
@@ -10,9 +13,26 @@ func (a *NitricAzurePulumiProvider) Queue(ctx *pulumi.Context, parent pulumi.Res
 	var err error
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
 
+	a.DeadLetterGrants.AddQueue(name, config)
+
+	metadata, err := azuredeploy.QueueMetadata(name, config)
+	if err != nil {
+		return err
+	}
+
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		deadLetterQueue, ok := a.Queues[deadLetter.QueueName]
+		if !ok {
+			return fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+		}
+
+		opts = append(opts, pulumi.DependsOn([]pulumi.Resource{deadLetterQueue}))
+	}
+
 	a.Queues[name], err = storage.NewQueue(ctx, ResourceName(ctx, name, StorageQueueRT), &storage.QueueArgs{
 		AccountName:       a.StorageAccount.Name,
 		ResourceGroupName: a.ResourceGroup.Name,
+		Metadata:          pulumi.ToStringMap(metadata),
 	}, opts...)
 
 	return err
@@ -77,7 +77,7 @@ var roleDefinitions = map[resourcespb.Action]RoleDefinition{
 				DataActions: pulumi.StringArray{
 					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/read"),
 					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete"),
-					// pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/update"),
+					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/write"),
 				},
 				NotActions: pulumi.StringArray{},
 			},
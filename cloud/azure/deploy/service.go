@@ -42,6 +42,9 @@ import (
 	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
 )
 
+// healthPort is the port the membrane serves its health endpoints on
+const healthPort = 9002
+
 type ContainerAppArgs struct {
 	ResourceGroupName             pulumi.StringInput
 	Location                      pulumi.StringInput
@@ -268,6 +271,10 @@ func (p *NitricAzurePulumiProvider) Service(ctx *pulumi.Context, parent pulumi.R
 			Name:  pulumi.String("TOLERATE_MISSING_SERVICES"),
 			Value: pulumi.String("true"),
 		},
+		app.EnvironmentVarArgs{
+			Name:  pulumi.String("HEALTH_ADDRESS"),
+			Value: pulumi.Sprintf(":%d", healthPort),
+		},
 	}
 
 	if p.DatabaseServer != nil {
@@ -386,6 +393,28 @@ func (p *NitricAzurePulumiProvider) Service(ctx *pulumi.Context, parent pulumi.R
 						Memory: pulumi.Sprintf("%.2fGi", serviceConfig.ContainerApps.Memory),
 					},
 					Env: env,
+					Probes: app.ContainerAppProbeArray{
+						// The revision receives traffic once the membrane is ready to receive requests
+						app.ContainerAppProbeArgs{
+							Type: pulumi.String("Startup"),
+							HttpGet: app.ContainerAppProbeHttpGetArgs{
+								Path: pulumi.String("/readyz"),
+								Port: pulumi.Int(healthPort),
+							},
+							PeriodSeconds:    pulumi.Int(5),
+							FailureThreshold: pulumi.Int(10),
+						},
+						// The replica is restarted if the child process or membrane services stop
+						app.ContainerAppProbeArgs{
+							Type: pulumi.String("Liveness"),
+							HttpGet: app.ContainerAppProbeHttpGetArgs{
+								Path: pulumi.String("/livez"),
+								Port: pulumi.Int(healthPort),
+							},
+							PeriodSeconds:    pulumi.Int(10),
+							FailureThreshold: pulumi.Int(3),
+						},
+					},
 				},
 			},
 		},
@@ -3,5 +3,5 @@ resource "azurerm_storage_queue" "queue" {
   name                  = var.name
   storage_account_name  = var.storage_account_name
 
-  # metadata = var.tags
+  metadata              = var.metadata
 }
\ No newline at end of file
@@ -12,4 +12,10 @@ variable "tags" {
   description = "the tags to apply to the queue"
   type        = map(string)
   nullable    = true
-}
\ No newline at end of file
+}
+
+variable "metadata" {
+  description = "the metadata to apply to the queue, including its dead letter config"
+  type        = map(string)
+  default     = {}
+}
@@ -78,6 +78,7 @@ resource "azurerm_role_definition" "nitric_role_queue_dequeue" {
     ]
     data_actions = [
       "Microsoft.Storage/storageAccounts/queueServices/queues/messages/read",
+      "Microsoft.Storage/storageAccounts/queueServices/queues/messages/write",
       "Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete"
     ]
     not_actions = []
@@ -200,6 +200,30 @@ resource "azurerm_container_app" "container_app" {
         name  = "TOLERATE_MISSING_SERVICES"
         value = "true"
       }
+
+      env {
+        name  = "HEALTH_ADDRESS"
+        value = ":9002"
+      }
+
+      # The revision receives traffic once the membrane is ready to receive requests
+      startup_probe {
+        transport               = "HTTP"
+        port                    = 9002
+        path                    = "/readyz"
+        interval_seconds        = 5
+        failure_count_threshold = 10
+      }
+
+      # The replica is restarted if the child process or membrane services stop
+      liveness_probe {
+        transport               = "HTTP"
+        port                    = 9002
+        path                    = "/livez"
+        interval_seconds        = 10
+        failure_count_threshold = 3
+      }
+
       dynamic "env" {
         for_each = var.env
         content {
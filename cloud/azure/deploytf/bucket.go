@@ -15,6 +15,8 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/bucket"
@@ -32,12 +34,17 @@ type BucketSubscriber struct {
 	EventType []*string `json:"event_type"`
 }
 
+// eventTypeToStorageEventType returns the Event Grid event types for a blob event type.
+// Metadata updates are not supported, blob storage doesn't publish an event for them.
 func eventTypeToStorageEventType(eventType storagepb.BlobEventType) []*string {
 	switch eventType {
 	case storagepb.BlobEventType_Created:
 		return []*string{jsii.String("Microsoft.Storage.BlobCreated")}
 	case storagepb.BlobEventType_Deleted:
 		return []*string{jsii.String("Microsoft.Storage.BlobDeleted")}
+	case storagepb.BlobEventType_Restored:
+		// only rehydrations from the archive tier are delivered to listeners, other tier changes are acknowledged and dropped
+		return []*string{jsii.String("Microsoft.Storage.BlobTierChanged")}
 	default:
 		return []*string{}
 	}
@@ -51,6 +58,11 @@ func (n *NitricAzureTerraformProvider) Bucket(stack cdktf.TerraformStack, name s
 	for _, v := range config.GetListeners() {
 		svc := n.Services[v.GetService()]
 
+		eventTypes := eventTypeToStorageEventType(v.GetConfig().BlobEventType)
+		if len(eventTypes) == 0 {
+			return fmt.Errorf("blob event type %s is not supported by blob storage events", v.GetConfig().BlobEventType)
+		}
+
 		listeners[v.GetService()] = BucketSubscriber{
 			EventGridSubscriber: EventGridSubscriber{
 				Url:                       svc.EndpointOutput(),
@@ -58,7 +70,7 @@ func (n *NitricAzureTerraformProvider) Bucket(stack cdktf.TerraformStack, name s
 				ActiveDirectoryTenantId:   svc.TenantIdOutput(),
 				EventToken:                svc.EventTokenOutput(),
 			},
-			EventType: eventTypeToStorageEventType(v.GetConfig().BlobEventType),
+			EventType: eventTypes,
 		}
 		allDependants = append(allDependants, svc)
 	}
@@ -24,6 +24,7 @@ import (
 	dockerprovider "github.com/cdktf/cdktf-provider-docker-go/docker/v11/provider"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/azure/common"
+	azuredeploy "github.com/nitrictech/nitric/cloud/azure/common/deploy"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/api"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/bucket"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/http_proxy"
@@ -62,6 +63,8 @@ type NitricAzureTerraformProvider struct {
 	Databases map[string]sql.Sql
 	Websites  map[string]website.Website
 
+	DeadLetterGrants *azuredeploy.DeadLetterGrants
+
 	EnableWebsites bool
 
 	SubscriptionId string
@@ -211,6 +214,13 @@ func (a *NitricAzureTerraformProvider) Pre(tfstack cdktf.TerraformStack, resourc
 }
 
 func (a *NitricAzureTerraformProvider) Post(stack cdktf.TerraformStack) error {
+	for i, policy := range a.DeadLetterGrants.Policies() {
+		err := a.Policy(stack, fmt.Sprintf("deadletter-%d", i), policy)
+		if err != nil {
+			return fmt.Errorf("dead letter queue policy: %w", err)
+		}
+	}
+
 	// Create a CDN for the stack if we have a website
 	if len(a.Websites) > 0 {
 		return a.NewCdn(stack)
@@ -230,5 +240,7 @@ func NewNitricAzureProvider() *NitricAzureTerraformProvider {
 		KvStores:  make(map[string]keyvalue.Keyvalue),
 		Databases: make(map[string]sql.Sql),
 		Websites:  make(map[string]website.Website),
+
+		DeadLetterGrants: azuredeploy.NewDeadLetterGrants(),
 	}
 }
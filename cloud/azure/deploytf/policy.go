@@ -150,6 +150,8 @@ func (p *NitricAzureTerraformProvider) scopeFromResource(resource *deploymentspb
 }
 
 func (a *NitricAzureTerraformProvider) Policy(stack cdktf.TerraformStack, name string, config *deploymentspb.Policy) error {
+	a.DeadLetterGrants.AddPolicy(config)
+
 	for _, resource := range config.Resources {
 		for _, principal := range config.Principals {
 			if principal.Id.Type != resourcespb.ResourceType_Service {
@@ -177,7 +179,7 @@ func (a *NitricAzureTerraformProvider) Policy(stack cdktf.TerraformStack, name s
 					return err
 				}
 
-				policy.NewPolicy(stack, jsii.Sprintf("%s-%s", principal.Id.Name, roleName), &policy.PolicyConfig{
+				policy.NewPolicy(stack, jsii.Sprintf("%s-%s-%s", principal.Id.Name, roleName, resource.Id.Name), &policy.PolicyConfig{
 					ServicePrincipalId: spId,
 					Scope:              scope.Scope,
 					RoleDefinitionId:   role,
@@ -15,8 +15,11 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
+	azuredeploy "github.com/nitrictech/nitric/cloud/azure/common/deploy"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/queue"
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
@@ -24,10 +27,36 @@ import (
 
 // // Queue - Deploy a Queue
 func (a *NitricAzureTerraformProvider) Queue(stack cdktf.TerraformStack, name string, config *deploymentspb.Queue) error {
-	a.Queues[name] = queue.NewQueue(stack, jsii.String(name), &queue.QueueConfig{
+	a.DeadLetterGrants.AddQueue(name, config)
+
+	metadata, err := azuredeploy.QueueMetadata(name, config)
+	if err != nil {
+		return err
+	}
+
+	dependsOn := []cdktf.ITerraformDependable{}
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		deadLetterQueue, ok := a.Queues[deadLetter.QueueName]
+		if !ok {
+			return fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+		}
+
+		dependsOn = append(dependsOn, deadLetterQueue)
+	}
+
+	q := queue.NewQueue(stack, jsii.String(name), &queue.QueueConfig{
 		Name:               jsii.String(name),
 		StorageAccountName: a.Stack.StorageAccountNameOutput(),
 		Tags:               a.GetTags(*a.Stack.StackIdOutput(), name, resources.Queue),
+		DependsOn:          &dependsOn,
 	})
+
+	// metadata is an optional module variable, so it's set directly on the module
+	if len(metadata) > 0 {
+		q.AddOverride(jsii.String("metadata"), metadata)
+	}
+
+	a.Queues[name] = q
+
 	return nil
 }
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/cloud/azure/runtime/storage/iface (interfaces: AzblobServiceUrlIface,AzblobContainerUrlIface,AzblobBlockBlobUrlIface,AzblobDownloadResponse)
+// Source: github.com/nitrictech/nitric/cloud/azure/runtime/storage/iface (interfaces: AzblobServiceUrlIface,AzblobContainerUrlIface,AzblobBlockBlobUrlIface,AzblobDownloadResponse,AzblobCopyResponse)
 
 // Package mock_azblob is a generated GoMock package.
 package mock_azblob
@@ -105,6 +105,21 @@ func (mr *MockAzblobContainerUrlIfaceMockRecorder) ListBlobsFlatSegment(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobsFlatSegment", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).ListBlobsFlatSegment), arg0, arg1, arg2)
 }
 
+// ListBlobsHierarchySegment mocks base method.
+func (m *MockAzblobContainerUrlIface) ListBlobsHierarchySegment(arg0 context.Context, arg1 azblob.Marker, arg2 string, arg3 azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsHierarchySegmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobsHierarchySegment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*azblob.ListBlobsHierarchySegmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobsHierarchySegment indicates an expected call of ListBlobsHierarchySegment.
+func (mr *MockAzblobContainerUrlIfaceMockRecorder) ListBlobsHierarchySegment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobsHierarchySegment", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).ListBlobsHierarchySegment), arg0, arg1, arg2, arg3)
+}
+
 // NewBlockBlobURL mocks base method.
 func (m *MockAzblobContainerUrlIface) NewBlockBlobURL(arg0 string) azblob_service_iface.AzblobBlockBlobUrlIface {
 	m.ctrl.T.Helper()
@@ -142,6 +157,21 @@ func (m *MockAzblobBlockBlobUrlIface) EXPECT() *MockAzblobBlockBlobUrlIfaceMockR
 	return m.recorder
 }
 
+// CommitBlockList mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) CommitBlockList(arg0 context.Context, arg1 []string, arg2 azblob.BlobHTTPHeaders, arg3 azblob.Metadata, arg4 azblob.BlobAccessConditions, arg5 azblob.AccessTierType, arg6 azblob.BlobTagsMap, arg7 azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobCommitBlockListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitBlockList", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(*azblob.BlockBlobCommitBlockListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitBlockList indicates an expected call of CommitBlockList.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) CommitBlockList(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBlockList", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).CommitBlockList), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
 // Delete mocks base method.
 func (m *MockAzblobBlockBlobUrlIface) Delete(arg0 context.Context, arg1 azblob.DeleteSnapshotsOptionType, arg2 azblob.BlobAccessConditions) (*azblob.BlobDeleteResponse, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +217,36 @@ func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) GetProperties(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperties", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).GetProperties), arg0, arg1, arg2)
 }
 
+// StageBlock mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) StageBlock(arg0 context.Context, arg1 string, arg2 io.ReadSeeker, arg3 azblob.LeaseAccessConditions, arg4 []byte, arg5 azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobStageBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageBlock", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*azblob.BlockBlobStageBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StageBlock indicates an expected call of StageBlock.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) StageBlock(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageBlock", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).StageBlock), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// StartCopyFromURL mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) StartCopyFromURL(arg0 context.Context, arg1 url.URL, arg2 azblob.Metadata, arg3 azblob.ModifiedAccessConditions, arg4 azblob.BlobAccessConditions, arg5 azblob.AccessTierType, arg6 azblob.BlobTagsMap) (azblob_service_iface.AzblobCopyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCopyFromURL", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(azblob_service_iface.AzblobCopyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartCopyFromURL indicates an expected call of StartCopyFromURL.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) StartCopyFromURL(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCopyFromURL", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).StartCopyFromURL), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // Upload mocks base method.
 func (m *MockAzblobBlockBlobUrlIface) Upload(arg0 context.Context, arg1 io.ReadSeeker, arg2 azblob.BlobHTTPHeaders, arg3 azblob.Metadata, arg4 azblob.BlobAccessConditions, arg5 azblob.AccessTierType, arg6 azblob.BlobTagsMap, arg7 azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobUploadResponse, error) {
 	m.ctrl.T.Helper()
@@ -252,3 +312,40 @@ func (mr *MockAzblobDownloadResponseMockRecorder) Body(arg0 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).Body), arg0)
 }
+
+// MockAzblobCopyResponse is a mock of AzblobCopyResponse interface.
+type MockAzblobCopyResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockAzblobCopyResponseMockRecorder
+}
+
+// MockAzblobCopyResponseMockRecorder is the mock recorder for MockAzblobCopyResponse.
+type MockAzblobCopyResponseMockRecorder struct {
+	mock *MockAzblobCopyResponse
+}
+
+// NewMockAzblobCopyResponse creates a new mock instance.
+func NewMockAzblobCopyResponse(ctrl *gomock.Controller) *MockAzblobCopyResponse {
+	mock := &MockAzblobCopyResponse{ctrl: ctrl}
+	mock.recorder = &MockAzblobCopyResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAzblobCopyResponse) EXPECT() *MockAzblobCopyResponseMockRecorder {
+	return m.recorder
+}
+
+// CopyStatus mocks base method.
+func (m *MockAzblobCopyResponse) CopyStatus() azblob.CopyStatusType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyStatus")
+	ret0, _ := ret[0].(azblob.CopyStatusType)
+	return ret0
+}
+
+// CopyStatus indicates an expected call of CopyStatus.
+func (mr *MockAzblobCopyResponseMockRecorder) CopyStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyStatus", reflect.TypeOf((*MockAzblobCopyResponse)(nil).CopyStatus))
+}
@@ -35,9 +35,14 @@ func (g *AzureApiGatewayProvider) ApiDetails(ctx context.Context, req *apispb.Ap
 		return nil, err
 	}
 
-	return &apispb.ApiDetailsResponse{
-		Url: gwDetails.Url,
-	}, nil
+	details, err := g.RouteWorkerManager.ApiDetails(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	details.Url = gwDetails.Url
+
+	return details, nil
 }
 
 func NewAzureApiGatewayProvider(provider resource.AzResourceResolver) *AzureApiGatewayProvider {
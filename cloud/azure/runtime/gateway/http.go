@@ -130,10 +130,10 @@ func (a *azMiddleware) handleSubscription(opts *gateway.GatewayStartOpts) fastht
 				},
 			}
 
-			resp, err := opts.TopicsListenerPlugin.HandleRequest(evt)
+			resp, err := opts.TopicsListenerPlugin.HandleRequest(ctx, evt)
 			if err != nil {
 				logger.Errorf("error handling event from topic %s: %s", topicName, err.Error())
-				ctx.Error("failed handling event, error returned from subscriber function", 500)
+				ctx.Error("failed handling event, error returned from subscriber function", base_http.WorkerErrorStatus(err))
 				return
 			}
 
@@ -164,22 +164,37 @@ func (a *azMiddleware) handleSchedule(opts *gateway.GatewayStartOpts) fasthttp.R
 			},
 		}
 
-		_, err := opts.SchedulesPlugin.HandleRequest(evt)
+		_, err := opts.SchedulesPlugin.HandleRequest(ctx, evt)
 		if err != nil {
-			ctx.Error(fmt.Sprintf("failed handling schedule %s", scheduleName), 500)
+			ctx.Error(fmt.Sprintf("failed handling schedule %s", scheduleName), base_http.WorkerErrorStatus(err))
 		}
 
 		ctx.SuccessString("text/plain", "success")
 	}
 }
 
-// Converts the GCP event type to our abstract event type
-func notificationEventToEventType(eventType *string) (*storagepb.BlobEventType, error) {
+// blobEventData is the subset of the data of blob storage events included in blob events
+type blobEventData struct {
+	ContentType   string `mapstructure:"contentType"`
+	ContentLength int64  `mapstructure:"contentLength"`
+	ETag          string `mapstructure:"eTag"`
+	PreviousTier  string `mapstructure:"previousTier"`
+}
+
+// Converts the Azure event type to our abstract event type.
+// Returns nil for tier changes that aren't a rehydration from the archive tier, which have no equivalent event.
+func notificationEventToEventType(eventType *string, data blobEventData) (*storagepb.BlobEventType, error) {
 	switch *eventType {
 	case "Microsoft.Storage.BlobCreated":
 		return storagepb.BlobEventType_Created.Enum(), nil
 	case "Microsoft.Storage.BlobDeleted":
 		return storagepb.BlobEventType_Deleted.Enum(), nil
+	case "Microsoft.Storage.BlobTierChanged":
+		if strings.EqualFold(data.PreviousTier, "Archive") {
+			return storagepb.BlobEventType_Restored.Enum(), nil
+		}
+
+		return nil, nil
 	default:
 		return nil, fmt.Errorf("unsupported bucket notification event type %s", *eventType)
 	}
@@ -215,13 +230,25 @@ func (a *azMiddleware) handleBucketNotification(opts *gateway.GatewayStartOpts)
 
 			logger.Debugf("identified bucket event from %s", bucketName)
 
-			eventType, err := notificationEventToEventType(event.EventType)
+			// the event data is best effort, the event is still delivered without it
+			var data blobEventData
+			if err := mapstructure.Decode(event.Data, &data); err != nil {
+				logger.Debugf("unable to decode bucket event data: %s", err.Error())
+			}
+
+			eventType, err := notificationEventToEventType(event.EventType, data)
 			if err != nil {
 				logger.Errorf("unable to parse bucket event type: %s", err.Error())
 				ctx.Error(err.Error(), 400)
 				return
 			}
 
+			if eventType == nil {
+				logger.Debugf("ignoring bucket event type: %s", *event.EventType)
+				ctx.SuccessString("text/plain", "success")
+				continue
+			}
+
 			logger.Debugf("handling bucket event type: %s", *eventType)
 
 			// Subject is in the form: "/blobServices/default/containers/test-container/blobs/new-file.txt"
@@ -241,18 +268,21 @@ func (a *azMiddleware) handleBucketNotification(opts *gateway.GatewayStartOpts)
 						BucketName: bucketName,
 						Event: &storagepb.BlobEventRequest_BlobEvent{
 							BlobEvent: &storagepb.BlobEvent{
-								Key:  eventKey,
-								Type: *eventType,
+								Key:         eventKey,
+								Type:        *eventType,
+								Size:        data.ContentLength,
+								Etag:        data.ETag,
+								ContentType: data.ContentType,
 							},
 						},
 					},
 				},
 			}
 
-			resp, err := opts.StorageListenerPlugin.HandleRequest(evt)
+			resp, err := opts.StorageListenerPlugin.HandleRequest(ctx, evt)
 			if err != nil {
 				logger.Errorf("error handling event: %s", err)
-				ctx.Error("error handling event", 500)
+				ctx.Error("error handling event", base_http.WorkerErrorStatus(err))
 				return
 			}
 
@@ -112,7 +112,7 @@ var _ = Describe("Http", func() {
 					},
 				}
 
-				mockManager.EXPECT().HandleRequest("test", test.ProtoEq(mockRequest)).Return(&apispb.ClientMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), "test", test.ProtoEq(mockRequest)).Return(&apispb.ClientMessage{
 					Id: "TODO",
 					Content: &apispb.ClientMessage_HttpResponse{
 						HttpResponse: &apispb.HttpResponse{
@@ -213,7 +213,7 @@ var _ = Describe("Http", func() {
 				}
 
 				By("Handling exactly 1 request")
-				mockManager.EXPECT().HandleRequest(test.ProtoEq(mockRequest)).Return(&topicspb.ClientMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), test.ProtoEq(mockRequest)).Return(&topicspb.ClientMessage{
 					Content: &topicspb.ClientMessage_MessageResponse{
 						MessageResponse: &topicspb.MessageResponse{
 							Success: true,
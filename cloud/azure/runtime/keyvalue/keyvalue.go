@@ -35,6 +35,9 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// maxTransactionActions is the maximum number of entity operations in a single table transaction
+const maxTransactionActions = 100
+
 // AzureStorageTableKeyValueService - an Azure Storage Table implementation of the Nitric Key/Value Service
 type AzureStorageTableKeyValueService struct {
 	clientFactory AzureStorageClientFactory
@@ -47,6 +50,136 @@ type AztableEntity struct {
 	aztables.Entity
 
 	Content aztables.EDMBinary
+
+	// Expiry is the time a value set with a ttl expires at, table storage has no TTL support so reads filter on it
+	Expiry     *aztables.EDMDateTime `json:",omitempty"`
+	ExpiryType string                `json:"Expiry@odata.type,omitempty"`
+}
+
+// expired returns true if the entity has an expiry that has passed
+func (e *AztableEntity) expired(now time.Time) bool {
+	return e.Expiry != nil && !now.Before(time.Time(*e.Expiry))
+}
+
+// setEntity writes an entity, subject to the conditions of a set request
+func setEntity(ctx context.Context, client *aztables.Client, req *kvstorepb.KvStoreSetValueRequest, entityJson []byte, now time.Time) error {
+	if req.IfMatch != "" {
+		etag := azcore.ETag(req.IfMatch)
+		_, err := client.UpdateEntity(ctx, entityJson, &aztables.UpdateEntityOptions{
+			IfMatch:    &etag,
+			UpdateMode: aztables.UpdateModeReplace,
+		})
+		return err
+	}
+
+	if !req.IfNotExists {
+		_, err := client.UpsertEntity(ctx, entityJson, &aztables.UpsertEntityOptions{
+			UpdateMode: aztables.UpdateModeReplace,
+		})
+		return err
+	}
+
+	_, err := client.AddEntity(ctx, entityJson, nil)
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) || respErr.StatusCode != http.StatusConflict {
+		return err
+	}
+
+	// an expired entity that hasn't been removed yet doesn't count as existing, so it's replaced as long as it's unchanged
+	existing, getErr := client.GetEntity(ctx, req.Ref.Store, req.Ref.Key, nil)
+	if getErr != nil {
+		return err
+	}
+
+	var entity AztableEntity
+	if json.Unmarshal(existing.Value, &entity) != nil || !entity.expired(now) {
+		return err
+	}
+
+	_, err = client.UpdateEntity(ctx, entityJson, &aztables.UpdateEntityOptions{
+		IfMatch:    &existing.ETag,
+		UpdateMode: aztables.UpdateModeReplace,
+	})
+
+	return err
+}
+
+// marshalEntity converts a value to the JSON of its table entity
+func marshalEntity(ref *kvstorepb.ValueRef, value *structpb.Struct, ttl time.Duration, now time.Time) ([]byte, error) {
+	content, err := proto.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal content")
+	}
+
+	entity := AztableEntity{
+		Entity: aztables.Entity{
+			PartitionKey: ref.Store,
+			RowKey:       ref.Key,
+			Timestamp:    aztables.EDMDateTime(now),
+		},
+		Content: content,
+	}
+
+	if ttl > 0 {
+		expiry := aztables.EDMDateTime(now.Add(ttl))
+		entity.Expiry = &expiry
+		entity.ExpiryType = "Edm.DateTime"
+	}
+
+	return json.Marshal(entity)
+}
+
+// storeIndexes groups the indexes of batched keys by store, as each store is a separate table, in the order the stores first appear
+func storeIndexes(refs []*kvstorepb.ValueRef) ([]string, map[string][]int) {
+	stores := []string{}
+	indexes := map[string][]int{}
+
+	for i, ref := range refs {
+		if _, ok := indexes[ref.Store]; !ok {
+			stores = append(stores, ref.Store)
+		}
+
+		indexes[ref.Store] = append(indexes[ref.Store], i)
+	}
+
+	return stores, indexes
+}
+
+// submitTransactions submits actions on a single table in transactions of at most maxTransactionActions,
+// returning the error of the transaction each action was part of, nil if it succeeded
+func submitTransactions(ctx context.Context, client *aztables.Client, actions []aztables.TransactionAction) []error {
+	errs := make([]error, len(actions))
+
+	for start := 0; start < len(actions); start += maxTransactionActions {
+		end := min(start+maxTransactionActions, len(actions))
+
+		// transactions are atomic, so the whole transaction fails if any of its actions fail
+		if _, err := client.SubmitTransaction(ctx, actions[start:end], nil); err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = err
+			}
+		}
+	}
+
+	return errs
+}
+
+// batchErr converts the error of a batched request to the error reported for each of its keys
+func batchErr(newErr grpc_errors.ScopedErrorFactory, err error) error {
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden {
+		return newErr(
+			codes.PermissionDenied,
+			"unable to complete batch request, this may be due to a missing permissions request in your code.",
+			err,
+		)
+	}
+
+	return newErr(
+		codes.Unknown,
+		"failed to call aztables.SubmitTransaction",
+		err,
+	)
 }
 
 func normalizeStoreName(storeName string) string {
@@ -113,6 +246,14 @@ func (s *AzureStorageTableKeyValueService) GetValue(ctx context.Context, req *kv
 		)
 	}
 
+	if entity.expired(time.Now()) {
+		return nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("key %s not found in store %s", req.Ref.Key, req.Ref.Store),
+			nil,
+		)
+	}
+
 	var structContent structpb.Struct
 	err = proto.Unmarshal(entity.Content, &structContent)
 	if err != nil {
@@ -127,6 +268,7 @@ func (s *AzureStorageTableKeyValueService) GetValue(ctx context.Context, req *kv
 		Value: &kvstorepb.Value{
 			Ref:     req.Ref,
 			Content: &structContent,
+			Version: string(response.ETag),
 		},
 	}, nil
 }
@@ -151,25 +293,25 @@ func (s *AzureStorageTableKeyValueService) SetValue(ctx context.Context, req *kv
 		)
 	}
 
-	content, err := proto.Marshal(req.Content)
-	if err != nil {
+	if err := document.ValidateTtl(req.Ttl); err != nil {
 		return nil, newErr(
-			codes.Internal,
-			"Unable to marshal content",
+			codes.InvalidArgument,
+			"invalid ttl",
 			err,
 		)
 	}
 
-	entity := AztableEntity{
-		Entity: aztables.Entity{
-			PartitionKey: req.Ref.Store,
-			RowKey:       req.Ref.Key,
-			Timestamp:    aztables.EDMDateTime(time.Now()),
-		},
-		Content: content,
+	if err := document.ValidateConditions(req.IfMatch, req.IfNotExists); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid conditions",
+			err,
+		)
 	}
 
-	entityJson, err := json.Marshal(entity)
+	now := time.Now()
+
+	entityJson, err := marshalEntity(req.Ref, req.Content, req.Ttl.AsDuration(), now)
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -178,13 +320,18 @@ func (s *AzureStorageTableKeyValueService) SetValue(ctx context.Context, req *kv
 		)
 	}
 
-	_, err = client.UpsertEntity(ctx, entityJson, &aztables.UpsertEntityOptions{
-		UpdateMode: aztables.UpdateModeReplace,
-	})
+	err = setEntity(ctx, client, req, entityJson, now)
 	if err != nil {
 		var respErr *azcore.ResponseError
 		if errors.As(err, &respErr) {
 			switch respErr.StatusCode {
+			case http.StatusConflict, http.StatusPreconditionFailed, http.StatusNotFound:
+				// conflicts are only possible with if_not_exists, and a missing entity only with if_match
+				return nil, newErr(
+					codes.FailedPrecondition,
+					fmt.Sprintf("value with key %s in store %s does not satisfy the set conditions", req.Ref.Key, req.Ref.Store),
+					err,
+				)
 			case http.StatusForbidden:
 				// Handle forbidden error
 				return nil, newErr(
@@ -197,7 +344,7 @@ func (s *AzureStorageTableKeyValueService) SetValue(ctx context.Context, req *kv
 
 		return nil, newErr(
 			codes.Unknown,
-			"unable to call aztables.UpsertEntity",
+			"unable to set entity",
 			err,
 		)
 	}
@@ -225,12 +372,32 @@ func (s *AzureStorageTableKeyValueService) DeleteKey(ctx context.Context, req *k
 		)
 	}
 
-	_, err = client.DeleteEntity(ctx, req.Ref.Store, req.Ref.Key, nil)
+	deleteOptions := &aztables.DeleteEntityOptions{}
+	if req.IfMatch != "" {
+		etag := azcore.ETag(req.IfMatch)
+		deleteOptions.IfMatch = &etag
+	}
+
+	_, err = client.DeleteEntity(ctx, req.Ref.Store, req.Ref.Key, deleteOptions)
 	if err != nil {
 		var respErr *azcore.ResponseError
 		if errors.As(err, &respErr) {
 			switch respErr.StatusCode {
+			case http.StatusPreconditionFailed:
+				return nil, newErr(
+					codes.FailedPrecondition,
+					fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch),
+					err,
+				)
 			case http.StatusNotFound:
+				if req.IfMatch != "" {
+					return nil, newErr(
+						codes.FailedPrecondition,
+						fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch),
+						err,
+					)
+				}
+
 				// not found isn't an error for delete
 				return &kvstorepb.KvStoreDeleteKeyResponse{}, nil
 			case http.StatusForbidden:
@@ -266,6 +433,14 @@ func (s *AzureStorageTableKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKe
 		)
 	}
 
+	if err := document.ValidateScanLimit(req.Limit); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid limit",
+			err,
+		)
+	}
+
 	client, err := s.clientFactory(normalizeStoreName(storeName))
 	if err != nil {
 		return newErr(
@@ -276,7 +451,18 @@ func (s *AzureStorageTableKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKe
 	}
 
 	// ge "GreaterThanOrEqual" is used for string prefix filtering (https://learn.microsoft.com/en-us/rest/api/storageservices/querying-tables-and-entities#filtering-on-string-properties)
-	keyFilter := fmt.Sprintf("PartitionKey eq '%s' and RowKey ge '%s'", storeName, req.GetPrefix())
+	keyFilter := fmt.Sprintf("PartitionKey eq '%s' and RowKey ge '%s'", escapeFilterValue(storeName), escapeFilterValue(req.GetPrefix()))
+	if prefixEnd := document.PrefixEnd(req.GetPrefix()); prefixEnd != "" {
+		keyFilter += fmt.Sprintf(" and RowKey lt '%s'", escapeFilterValue(prefixEnd))
+	}
+
+	if req.StartAfter != "" {
+		operator := "gt"
+		if req.Reverse {
+			operator = "lt"
+		}
+		keyFilter += fmt.Sprintf(" and RowKey %s '%s'", operator, escapeFilterValue(req.StartAfter))
+	}
 
 	pager := client.NewListEntitiesPager(
 		&aztables.ListEntitiesOptions{
@@ -284,7 +470,12 @@ func (s *AzureStorageTableKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKe
 		},
 	)
 
-	for pager.More() {
+	now := time.Now()
+	keys := []string{}
+
+	// entities are returned in ascending key order, so a forward scan stops once it has one key beyond the limit to determine if more keys remain,
+	// while a reverse scan reads the whole range as table storage has no descending order
+	for pager.More() && (req.Reverse || req.Limit == 0 || len(keys) <= int(req.Limit)) {
 		response, err := pager.NextPage(context.TODO())
 		if err != nil {
 			var respErr *azcore.ResponseError
@@ -317,21 +508,192 @@ func (s *AzureStorageTableKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKe
 				)
 			}
 
-			if err := stream.Send(&kvstorepb.KvStoreScanKeysResponse{
-				Key: entity.RowKey,
-			}); err != nil {
-				return newErr(
-					codes.Internal,
-					"failed to send response",
-					err,
-				)
+			if entity.expired(now) {
+				continue
 			}
+
+			keys = append(keys, entity.RowKey)
 		}
 	}
 
+	keys, continuationToken := document.PageKeys(keys, req)
+
+	if err := document.SendKeys(stream, keys, continuationToken); err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to send response",
+			err,
+		)
+	}
+
 	return nil
 }
 
+// escapeFilterValue escapes a string for use as a quoted value in a table query filter
+func escapeFilterValue(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// GetValues retrieves multiple values from Azure Storage tables, reporting a result for each key
+func (s *AzureStorageTableKeyValueService) GetValues(ctx context.Context, req *kvstorepb.KvStoreGetValuesRequest) (*kvstorepb.KvStoreGetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzureStorageTableKeyValueService.GetValues")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	// table transactions only support writes, so values are retrieved individually
+	results := make([]*kvstorepb.KvStoreBatchResult, 0, len(req.Refs))
+	for _, ref := range req.Refs {
+		resp, err := s.GetValue(ctx, &kvstorepb.KvStoreGetValueRequest{Ref: ref})
+		results = append(results, document.BatchResult(ref, resp.GetValue(), err))
+	}
+
+	return &kvstorepb.KvStoreGetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// SetValues creates or overwrites multiple values using a transaction per table, reporting a result for each key
+func (s *AzureStorageTableKeyValueService) SetValues(ctx context.Context, req *kvstorepb.KvStoreSetValuesRequest) (*kvstorepb.KvStoreSetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzureStorageTableKeyValueService.SetValues")
+
+	refs := make([]*kvstorepb.ValueRef, 0, len(req.Values))
+	for _, value := range req.Values {
+		refs = append(refs, value.GetRef())
+	}
+
+	if err := document.ValidateValueRefs(refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	if err := document.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid ttl",
+			err,
+		)
+	}
+
+	now := time.Now()
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Values))
+
+	stores, indexes := storeIndexes(refs)
+	for _, store := range stores {
+		client, err := s.clientFactory(normalizeStoreName(store))
+		if err != nil {
+			for _, i := range indexes[store] {
+				results[i] = document.BatchResult(refs[i], nil, newErr(codes.Internal, "Unable to create client", err))
+			}
+			continue
+		}
+
+		actionIndexes := make([]int, 0, len(indexes[store]))
+		actions := make([]aztables.TransactionAction, 0, len(indexes[store]))
+		for _, i := range indexes[store] {
+			if req.Values[i].Content == nil {
+				results[i] = document.BatchResult(refs[i], nil, newErr(codes.InvalidArgument, "value content must not be nil", nil))
+				continue
+			}
+
+			entityJson, err := marshalEntity(refs[i], req.Values[i].Content, req.Ttl.AsDuration(), now)
+			if err != nil {
+				results[i] = document.BatchResult(refs[i], nil, newErr(codes.Internal, "unable to convert struct to json", err))
+				continue
+			}
+
+			actionIndexes = append(actionIndexes, i)
+			actions = append(actions, aztables.TransactionAction{
+				ActionType: aztables.TransactionTypeInsertReplace,
+				Entity:     entityJson,
+			})
+		}
+
+		for j, err := range submitTransactions(ctx, client, actions) {
+			if err != nil {
+				err = batchErr(newErr, err)
+			}
+
+			results[actionIndexes[j]] = document.BatchResult(refs[actionIndexes[j]], nil, err)
+		}
+	}
+
+	return &kvstorepb.KvStoreSetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// DeleteKeys removes multiple keys using a transaction per table, reporting a result for each key
+func (s *AzureStorageTableKeyValueService) DeleteKeys(ctx context.Context, req *kvstorepb.KvStoreDeleteKeysRequest) (*kvstorepb.KvStoreDeleteKeysResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzureStorageTableKeyValueService.DeleteKeys")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Refs))
+
+	stores, indexes := storeIndexes(req.Refs)
+	for _, store := range stores {
+		client, err := s.clientFactory(normalizeStoreName(store))
+		if err != nil {
+			for _, i := range indexes[store] {
+				results[i] = document.BatchResult(req.Refs[i], nil, newErr(codes.Internal, "Unable to create client", err))
+			}
+			continue
+		}
+
+		actionIndexes := make([]int, 0, len(indexes[store]))
+		actions := make([]aztables.TransactionAction, 0, len(indexes[store]))
+		for _, i := range indexes[store] {
+			entityJson, err := json.Marshal(aztables.Entity{
+				PartitionKey: req.Refs[i].Store,
+				RowKey:       req.Refs[i].Key,
+			})
+			if err != nil {
+				results[i] = document.BatchResult(req.Refs[i], nil, newErr(codes.Internal, "unable to convert key to json", err))
+				continue
+			}
+
+			actionIndexes = append(actionIndexes, i)
+			actions = append(actions, aztables.TransactionAction{
+				ActionType: aztables.TransactionTypeDelete,
+				Entity:     entityJson,
+			})
+		}
+
+		for j, err := range submitTransactions(ctx, client, actions) {
+			ref := req.Refs[actionIndexes[j]]
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+				// a transaction fails if any of its keys don't exist, which isn't an error for delete, so the key is deleted on its own instead
+				_, err = s.DeleteKey(ctx, &kvstorepb.KvStoreDeleteKeyRequest{Ref: ref})
+			} else if err != nil {
+				err = batchErr(newErr, err)
+			}
+
+			results[actionIndexes[j]] = document.BatchResult(ref, nil, err)
+		}
+	}
+
+	return &kvstorepb.KvStoreDeleteKeysResponse{
+		Results: results,
+	}, nil
+}
+
 type AzureStorageClientFactory func(tableName string) (*aztables.Client, error)
 
 func newStorageTablesClientFactory(creds *azidentity.DefaultAzureCredential, storageAccountName string) AzureStorageClientFactory {
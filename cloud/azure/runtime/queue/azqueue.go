@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-storage-queue-go/azqueue"
@@ -29,9 +31,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 
+	azuredeploy "github.com/nitrictech/nitric/cloud/azure/common/deploy"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
 	azqueueserviceiface "github.com/nitrictech/nitric/cloud/azure/runtime/queue/iface"
 	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 
@@ -41,8 +45,26 @@ import (
 // Set to 30 seconds,
 const defaultVisibilityTimeout = 30 * time.Second
 
+// maxVisibilityTimeout is the longest Azure Storage Queues allows a message to be hidden, either after being dequeued or when delayed
+const maxVisibilityTimeout = 7 * 24 * time.Hour
+
+// maxWait is the longest a dequeue can wait for messages, matching the limit of SQS long polling so waits behave the same across providers
+const maxWait = 20 * time.Second
+
+// pollInterval is how often an empty queue is checked again while a dequeue is waiting, as Azure Storage Queues don't support long polling
+const pollInterval = time.Second
+
 type AzqueueQueueService struct {
 	client azqueueserviceiface.AzqueueServiceUrlIface
+	// dead letter config of each queue, read from the queue's metadata on first dequeue
+	deadLetters sync.Map
+}
+
+// deadLetterConfig - The dead letter config of a queue, Azure Storage Queues don't support dead lettering
+// so messages received more than maxReceives times are moved to the dead letter queue when dequeued.
+type deadLetterConfig struct {
+	maxReceives int64
+	queueName   string
 }
 
 var _ queuespb.QueuesServer = &AzqueueQueueService{}
@@ -69,12 +91,20 @@ func (s *AzqueueQueueService) getMessageIdUrl(queue string, messageId azqueue.Me
 func (s *AzqueueQueueService) send(ctx context.Context, queueName string, req *queuespb.QueueMessage) error {
 	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.Enqueue")
 
+	if err := queue.ValidateDelay(req.Delay, maxVisibilityTimeout); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid delay",
+			err,
+		)
+	}
+
 	messages := s.getMessagesUrl(queueName)
 
-	// Send the tasks to the queue
-	if taskBytes, err := proto.Marshal(req); err == nil {
+	// Send the tasks to the queue, delayed messages remain invisible until their delay has elapsed
+	if taskBytes, err := proto.Marshal(&queuespb.QueueMessage{Content: req.Content, ContentType: req.ContentType}); err == nil {
 		taskPayload := base64.StdEncoding.EncodeToString(taskBytes)
-		if _, err := messages.Enqueue(ctx, taskPayload, 0, 0); err != nil {
+		if _, err := messages.Enqueue(ctx, taskPayload, req.Delay.AsDuration(), 0); err != nil {
 			return newErr(
 				codes.Internal,
 				"error sending task to queue",
@@ -121,6 +151,9 @@ type AzureQueueItemLease struct {
 	ID string
 	// lease id, a new popReceipt is generated each time an item is dequeued.
 	PopReceipt string
+	// The original text of the queue item
+	// note: updating an item's visibility also replaces its text, so it's retained to be resent unchanged.
+	Text string
 }
 
 // String - convert the item lease struct to a string, to be returned as a NitricTask LeaseID
@@ -143,21 +176,103 @@ func leaseFromString(leaseID string) (*AzureQueueItemLease, error) {
 func (s *AzqueueQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeueRequest) (*queuespb.QueueDequeueResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.Dequeue")
 
-	messages := s.getMessagesUrl(req.QueueName)
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	if err := queue.ValidateWait(req.Wait, maxWait); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid wait time",
+			err,
+		)
+	}
 
-	dequeueResp, err := messages.Dequeue(ctx, req.Depth, defaultVisibilityTimeout)
+	visibilityTimeout := defaultVisibilityTimeout
+	if req.LeaseDuration != nil {
+		visibilityTimeout = req.LeaseDuration.AsDuration()
+	}
+
+	qUrl := s.client.NewQueueURL(req.QueueName)
+
+	deadLetter, err := s.getDeadLetter(ctx, req.QueueName, qUrl)
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
-			"failed to received messages from the queue",
+			"failed to read the queue dead letter config",
 			err,
 		)
 	}
 
-	if dequeueResp.NumMessages() == 0 {
-		return &queuespb.QueueDequeueResponse{
-			Messages: []*queuespb.DequeuedMessage{},
-		}, nil
+	messages := qUrl.NewMessageURL()
+
+	tasks, err := queue.Poll(ctx, req.Wait.AsDuration(), pollInterval, func() ([]*queuespb.DequeuedMessage, error) {
+		return s.receive(ctx, messages, req.Depth, visibilityTimeout, deadLetter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueDequeueResponse{
+		Messages: tasks,
+	}, nil
+}
+
+// getDeadLetter - Returns the dead letter config of a queue, or nil if it doesn't have a dead letter queue
+func (s *AzqueueQueueService) getDeadLetter(ctx context.Context, queueName string, qUrl azqueueserviceiface.AzqueueQueueUrlIface) (*deadLetterConfig, error) {
+	if config, ok := s.deadLetters.Load(queueName); ok {
+		return config.(*deadLetterConfig), nil
+	}
+
+	metadata, err := qUrl.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var config *deadLetterConfig
+	if deadLetterQueue, ok := metadata[azuredeploy.DeadLetterQueueMetadataKey]; ok {
+		maxReceives, err := strconv.ParseInt(metadata[azuredeploy.MaxReceivesMetadataKey], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max receives: %w", err)
+		}
+
+		config = &deadLetterConfig{
+			maxReceives: maxReceives,
+			queueName:   deadLetterQueue,
+		}
+	}
+
+	s.deadLetters.Store(queueName, config)
+
+	return config, nil
+}
+
+// moveToDeadLetterQueue - Moves a message that has been received too many times to the dead letter queue
+func (s *AzqueueQueueService) moveToDeadLetterQueue(ctx context.Context, messages azqueueserviceiface.AzqueueMessageUrlIface, m *azqueue.DequeuedMessage, deadLetterQueue string) error {
+	if _, err := s.getMessagesUrl(deadLetterQueue).Enqueue(ctx, m.Text, 0, 0); err != nil {
+		return err
+	}
+
+	_, err := messages.NewMessageIDURL(m.ID).Delete(ctx, m.PopReceipt)
+
+	return err
+}
+
+// receive - Dequeues up to depth messages from the queue, converting them into Nitric tasks
+func (s *AzqueueQueueService) receive(ctx context.Context, messages azqueueserviceiface.AzqueueMessageUrlIface, depth int32, visibilityTimeout time.Duration, deadLetter *deadLetterConfig) ([]*queuespb.DequeuedMessage, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.Dequeue")
+
+	dequeueResp, err := messages.Dequeue(ctx, depth, visibilityTimeout)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to received messages from the queue",
+			err,
+		)
 	}
 
 	// Convert the Azure Storage Queues messages into Nitric tasks
@@ -166,6 +281,14 @@ func (s *AzqueueQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDe
 		m := dequeueResp.Message(i)
 		var queueMessage queuespb.QueueMessage
 
+		if deadLetter != nil && m.DequeueCount > deadLetter.maxReceives {
+			// If moving the message fails it remains leased, so it will be moved again when the lease expires
+			if err := s.moveToDeadLetterQueue(ctx, messages, m, deadLetter.queueName); err != nil {
+				logger.Errorf("failed to move queue item to dead letter queue %s: %s", deadLetter.queueName, err.Error())
+			}
+			continue
+		}
+
 		fmt.Printf("deserializing payload: %s", m.Text)
 
 		// bytePayload := []byte(m.Text)
@@ -190,6 +313,7 @@ func (s *AzqueueQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDe
 		lease := AzureQueueItemLease{
 			ID:         m.ID.String(),
 			PopReceipt: m.PopReceipt.String(),
+			Text:       m.Text,
 		}
 		leaseID, err := lease.String()
 		// This should never happen, it's a fatal error
@@ -202,14 +326,13 @@ func (s *AzqueueQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDe
 		}
 
 		tasks = append(tasks, &queuespb.DequeuedMessage{
-			LeaseId: leaseID,
-			Message: &queueMessage,
+			LeaseId:      leaseID,
+			Message:      &queueMessage,
+			ReceiveCount: int32(m.DequeueCount),
 		})
 	}
 
-	return &queuespb.QueueDequeueResponse{
-		Messages: tasks,
-	}, nil
+	return tasks, nil
 }
 
 // Complete - Completes a previously popped queue item
@@ -239,6 +362,104 @@ func (s *AzqueueQueueService) Complete(ctx context.Context, req *queuespb.QueueC
 	return &queuespb.QueueCompleteResponse{}, nil
 }
 
+// ExtendLease - Extends the lease on a previously popped queue item, by updating its visibility timeout
+func (s *AzqueueQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.ExtendLease")
+
+	if req.LeaseDuration == nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"lease duration is required",
+			nil,
+		)
+	}
+
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	lease, err := s.updateVisibility(ctx, req.QueueName, req.LeaseId, req.LeaseDuration.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	leaseID, err := lease.String()
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to construct queue item lease id",
+			err,
+		)
+	}
+
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: leaseID,
+	}, nil
+}
+
+// Release - Returns a previously popped queue item to the queue without completing it, after an optional delay
+func (s *AzqueueQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.Release")
+
+	if err := queue.ValidateDelay(req.Delay, maxVisibilityTimeout); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid delay",
+			err,
+		)
+	}
+
+	if _, err := s.updateVisibility(ctx, req.QueueName, req.LeaseId, req.Delay.AsDuration()); err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// updateVisibility - Sets how long until a popped queue item becomes visible again, returning the updated lease
+func (s *AzqueueQueueService) updateVisibility(ctx context.Context, queueName string, leaseId string, visibilityTimeout time.Duration) (*AzureQueueItemLease, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.updateVisibility")
+
+	lease, err := leaseFromString(leaseId)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"failed to unmarshal lease id value",
+			err,
+		)
+	}
+
+	if lease.Text == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"lease id is missing the queue item text, dequeue the item again to obtain a new lease",
+			nil,
+		)
+	}
+
+	// Client for the specific message referenced by the lease
+	task := s.getMessageIdUrl(queueName, azqueue.MessageID(lease.ID))
+	resp, err := task.Update(ctx, azqueue.PopReceipt(lease.PopReceipt), visibilityTimeout, lease.Text)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to update task visibility",
+			err,
+		)
+	}
+
+	// updating the item invalidates the previous pop receipt
+	return &AzureQueueItemLease{
+		ID:         lease.ID,
+		PopReceipt: resp.PopReceipt.String(),
+		Text:       lease.Text,
+	}, nil
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azqueue.TokenRefresher {
@@ -22,7 +22,10 @@ import (
 
 	azqueue "github.com/Azure/azure-storage-queue-go/azqueue"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	. "github.com/onsi/ginkgo"
@@ -156,6 +159,9 @@ var _ = Describe("Azqueue", func() {
 				By("Retrieving the Queue URL for the requested queue")
 				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
 
+				By("Reading the dead letter config from the queue metadata")
+				mockQueue.EXPECT().GetMetadata(gomock.Any()).Times(1).Return(azqueue.Metadata{}, nil)
+
 				By("Retrieving the Message URL of the requested queue")
 				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
 
@@ -173,7 +179,7 @@ var _ = Describe("Azqueue", func() {
 					// ExpirationTime:  time.Time{},
 					PopReceipt:      "popreceipt",
 					NextVisibleTime: time.Time{},
-					DequeueCount:    0,
+					DequeueCount:    2,
 					Text:            testB64Payload,
 				})
 
@@ -189,6 +195,138 @@ var _ = Describe("Azqueue", func() {
 				Expect(len(resp.Messages)).To(Equal(1))
 				Expect(resp.Messages[0].Message.GetStructPayload().AsMap()).To(Equal(map[string]interface{}{"Test": "Test"}))
 
+				By("Returning the number of times the task has been received")
+				Expect(resp.Messages[0].ReceiveCount).To(Equal(int32(2)))
+
+				crtl.Finish()
+			})
+		})
+
+		When("Waiting for messages on an empty queue", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockEmptyResp := mock_azqueue.NewMockDequeueMessagesResponseIface(crtl)
+			mockDequeueResp := mock_azqueue.NewMockDequeueMessagesResponseIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should poll the queue until a message is available", func() {
+				By("Retrieving the Queue URL for the requested queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Reading the dead letter config from the queue metadata")
+				mockQueue.EXPECT().GetMetadata(gomock.Any()).Times(1).Return(azqueue.Metadata{}, nil)
+
+				By("Retrieving the Message URL of the requested queue")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				By("Calling Dequeue again after the queue is empty")
+				gomock.InOrder(
+					mockMessages.EXPECT().Dequeue(gomock.Any(), int32(1), 30*time.Second).Times(1).Return(mockEmptyResp, nil),
+					mockMessages.EXPECT().Dequeue(gomock.Any(), int32(1), 30*time.Second).Times(1).Return(mockDequeueResp, nil),
+				)
+
+				mockEmptyResp.EXPECT().NumMessages().AnyTimes().Return(int32(0))
+				mockDequeueResp.EXPECT().NumMessages().AnyTimes().Return(int32(1))
+				mockDequeueResp.EXPECT().Message(int32(0)).Times(1).Return(&azqueue.DequeuedMessage{
+					ID:         "testid",
+					PopReceipt: "popreceipt",
+					Text:       testB64Payload,
+				})
+
+				resp, err := queuePlugin.Dequeue(context.TODO(), &queuepb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+					Wait:      durationpb.New(5 * time.Second),
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Returning the dequeued task")
+				Expect(resp.Messages).To(HaveLen(1))
+
+				crtl.Finish()
+			})
+		})
+
+		When("The wait time exceeds the maximum", func() {
+			queuePlugin := &AzqueueQueueService{}
+
+			It("should return an invalid argument error", func() {
+				_, err := queuePlugin.Dequeue(context.TODO(), &queuepb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+					Wait:      durationpb.New(time.Minute),
+				})
+
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("A task has been received more than the queue's max receives", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockDeadLetterQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockDeadLetterMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+			mockDequeueResp := mock_azqueue.NewMockDequeueMessagesResponseIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should move the task to the dead letter queue", func() {
+				By("Reading the dead letter config from the queue metadata")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+				mockQueue.EXPECT().GetMetadata(gomock.Any()).Times(1).Return(azqueue.Metadata{
+					"nitric_dead_letter_queue": "test-dead-letter-queue",
+					"nitric_max_receives":      "3",
+				}, nil)
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				mockMessages.EXPECT().Dequeue(gomock.Any(), int32(2), 30*time.Second).Times(1).Return(mockDequeueResp, nil)
+				mockDequeueResp.EXPECT().NumMessages().AnyTimes().Return(int32(2))
+				mockDequeueResp.EXPECT().Message(int32(0)).Times(1).Return(&azqueue.DequeuedMessage{
+					ID:           "poisonid",
+					PopReceipt:   "poisonreceipt",
+					DequeueCount: 4,
+					Text:         testB64Payload,
+				})
+				mockDequeueResp.EXPECT().Message(int32(1)).Times(1).Return(&azqueue.DequeuedMessage{
+					ID:           "testid",
+					PopReceipt:   "popreceipt",
+					DequeueCount: 3,
+					Text:         testB64Payload,
+				})
+
+				By("Sending the task to the dead letter queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-dead-letter-queue").Times(1).Return(mockDeadLetterQueue)
+				mockDeadLetterQueue.EXPECT().NewMessageURL().Times(1).Return(mockDeadLetterMessages)
+				mockDeadLetterMessages.EXPECT().Enqueue(gomock.Any(), testB64Payload, time.Duration(0), time.Duration(0)).Times(1).Return(&azqueue.EnqueueMessageResponse{}, nil)
+
+				By("Deleting the task from the queue")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("poisonid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().Delete(gomock.Any(), azqueue.PopReceipt("poisonreceipt")).Times(1).Return(&azqueue.MessageIDDeleteResponse{}, nil)
+
+				resp, err := queuePlugin.Dequeue(context.TODO(), &queuepb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     2,
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Only returning the task within the max receives")
+				Expect(resp.Messages).To(HaveLen(1))
+				Expect(resp.Messages[0].ReceiveCount).To(Equal(int32(3)))
+
 				crtl.Finish()
 			})
 		})
@@ -209,6 +347,9 @@ var _ = Describe("Azqueue", func() {
 				By("Retrieving the Queue URL for the requested queue")
 				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
 
+				By("Reading the dead letter config from the queue metadata")
+				mockQueue.EXPECT().GetMetadata(gomock.Any()).Times(1).Return(azqueue.Metadata{}, nil)
+
 				By("Retrieving the Message URL of the requested queue")
 				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
 
@@ -315,4 +456,142 @@ var _ = Describe("Azqueue", func() {
 			})
 		})
 	})
+
+	Context("ExtendLease", func() {
+		When("Azure returns a successfully response", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should return a new lease for the queue item", func() {
+				By("Retrieving the Queue URL for the requested queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Retrieving the Message URL of the requested queue")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				lease := AzureQueueItemLease{
+					ID:         "testid",
+					PopReceipt: "testreceipt",
+					Text:       testB64Payload,
+				}
+				leaseStr, _ := lease.String()
+
+				By("Updating the visibility of the dequeued task with its original text")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("testid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().Update(
+					gomock.Any(),
+					azqueue.PopReceipt(lease.PopReceipt),
+					2*time.Minute,
+					testB64Payload,
+				).Times(1).Return(&azqueue.UpdatedMessageResponse{
+					PopReceipt: "newreceipt",
+				}, nil)
+
+				resp, err := queuePlugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+					QueueName:     "test-queue",
+					LeaseId:       leaseStr,
+					LeaseDuration: durationpb.New(2 * time.Minute),
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Returning a lease with the new pop receipt")
+				newLease, err := leaseFromString(resp.LeaseId)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newLease.PopReceipt).To(Equal("newreceipt"))
+				Expect(newLease.Text).To(Equal(testB64Payload))
+
+				crtl.Finish()
+			})
+		})
+
+		When("The lease duration exceeds the maximum", func() {
+			queuePlugin := &AzqueueQueueService{}
+
+			It("should return an invalid argument error", func() {
+				_, err := queuePlugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+					QueueName:     "test-queue",
+					LeaseId:       "{}",
+					LeaseDuration: durationpb.New(8 * 24 * time.Hour),
+				})
+
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Release", func() {
+		When("Azure returns a successfully response", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should make the queue item visible again immediately", func() {
+				By("Retrieving the Queue URL for the requested queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Retrieving the Message URL of the requested queue")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				lease := AzureQueueItemLease{
+					ID:         "testid",
+					PopReceipt: "testreceipt",
+					Text:       testB64Payload,
+				}
+				leaseStr, _ := lease.String()
+
+				By("Updating the visibility of the dequeued task to zero")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("testid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().Update(
+					gomock.Any(),
+					azqueue.PopReceipt(lease.PopReceipt),
+					time.Duration(0),
+					testB64Payload,
+				).Times(1).Return(&azqueue.UpdatedMessageResponse{}, nil)
+
+				_, err := queuePlugin.Release(context.TODO(), &queuepb.QueueReleaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseStr,
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				crtl.Finish()
+			})
+		})
+
+		When("The lease is missing the queue item text", func() {
+			queuePlugin := &AzqueueQueueService{}
+
+			It("should return an invalid argument error", func() {
+				lease := AzureQueueItemLease{
+					ID:         "testid",
+					PopReceipt: "testreceipt",
+				}
+				leaseStr, _ := lease.String()
+
+				_, err := queuePlugin.Release(context.TODO(), &queuepb.QueueReleaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseStr,
+				})
+
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
 })
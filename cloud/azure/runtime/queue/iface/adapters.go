@@ -59,6 +59,14 @@ func (c queueUrl) NewMessageURL() AzqueueMessageUrlIface {
 	return AdaptMessageUrl(c.c.NewMessagesURL())
 }
 
+func (c queueUrl) GetMetadata(ctx context.Context) (azqueue.Metadata, error) {
+	resp, err := c.c.GetProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.NewMetadata(), nil
+}
+
 func (c messageUrl) Enqueue(ctx context.Context, messageText string, visibilityTimeout time.Duration, timeToLive time.Duration) (*azqueue.EnqueueMessageResponse, error) {
 	return c.c.Enqueue(ctx, messageText, visibilityTimeout, timeToLive)
 }
@@ -79,6 +87,10 @@ func (c messageIdUrl) Delete(ctx context.Context, popReceipt azqueue.PopReceipt)
 	return c.c.Delete(ctx, popReceipt)
 }
 
+func (c messageIdUrl) Update(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration, message string) (*azqueue.UpdatedMessageResponse, error) {
+	return c.c.Update(ctx, popReceipt, visibilityTimeout, message)
+}
+
 func (c dequeueMessagesResponse) NumMessages() int32 {
 	return c.c.NumMessages()
 }
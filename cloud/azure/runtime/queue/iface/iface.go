@@ -27,6 +27,7 @@ type AzqueueServiceUrlIface interface {
 
 type AzqueueQueueUrlIface interface {
 	NewMessageURL() AzqueueMessageUrlIface
+	GetMetadata(ctx context.Context) (azqueue.Metadata, error)
 }
 
 type AzqueueMessageUrlIface interface {
@@ -37,6 +38,7 @@ type AzqueueMessageUrlIface interface {
 
 type AzqueueMessageIdUrlIface interface {
 	Delete(ctx context.Context, popReceipt azqueue.PopReceipt) (*azqueue.MessageIDDeleteResponse, error)
+	Update(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration, message string) (*azqueue.UpdatedMessageResponse, error)
 }
 
 type DequeueMessagesResponseIface interface {
@@ -17,15 +17,19 @@ package storage
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
 	azblob_service_iface "github.com/nitrictech/nitric/cloud/azure/runtime/storage/iface"
@@ -91,7 +95,10 @@ func (a *AzblobStorageService) Read(ctx context.Context, req *storagepb.StorageR
 func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Write")
 
-	contentType := content.DetectContentType(req.Key, req.Body)
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = content.DetectContentType(req.Key, req.Body)
+	}
 
 	blob := a.getBlobUrl(req.BucketName, req.Key)
 
@@ -99,9 +106,10 @@ func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.Storage
 		ctx,
 		bytes.NewReader(req.Body),
 		azblob.BlobHTTPHeaders{
-			ContentType: contentType,
+			ContentType:  contentType,
+			CacheControl: req.CacheControl,
 		},
-		azblob.Metadata{},
+		blobMetadata(req.Metadata),
 		azblob.BlobAccessConditions{},
 		azblob.DefaultAccessTier,
 		nil,
@@ -117,6 +125,143 @@ func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.Storage
 	return &storagepb.StorageWriteResponse{}, nil
 }
 
+func (a *AzblobStorageService) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.ReadStream")
+
+	if req.Offset < 0 || req.Length < 0 {
+		return newErr(
+			codes.InvalidArgument,
+			"offset and length cannot be negative",
+			nil,
+		)
+	}
+
+	blob := a.getBlobUrl(req.BucketName, req.Key)
+
+	// a length of 0 is azblob.CountToEnd, downloading the rest of the blob from the offset
+	r, err := blob.Download(
+		stream.Context(),
+		req.Offset,
+		req.Length,
+		azblob.BlobAccessConditions{},
+		false,
+		azblob.ClientProvidedKeyOptions{},
+	)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"Unable to download blob",
+			err,
+		)
+	}
+
+	data := r.Body(azblob.RetryReaderOptions{MaxRetryRequests: 20})
+	defer data.Close()
+
+	if err := content.SendChunks(data, stream); err != nil {
+		return newErr(
+			codes.Internal,
+			"Error streaming blob",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// blockSize is the size of the blocks streamed writes are staged in
+const blockSize = 8 * 1024 * 1024
+
+// blockId returns the ID of the nth block of a blob, all block IDs of a blob must be the same length
+func blockId(n int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", n)))
+}
+
+func (a *AzblobStorageService) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.WriteStream")
+	ctx := stream.Context()
+
+	metadata, body, err := content.NewWriteStreamReader(stream)
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"Invalid write stream",
+			err,
+		)
+	}
+
+	var reader io.Reader = body
+
+	contentType := metadata.ContentType
+	if contentType == "" {
+		contentType, reader = content.DetectStreamContentType(metadata.Key, body)
+	}
+
+	blob := a.getBlobUrl(metadata.BucketName, metadata.Key)
+
+	// stage the body as blocks, the blob is only written once the block list is committed
+	blockIds := []string{}
+	block := make([]byte, blockSize)
+
+	for {
+		n, err := io.ReadFull(reader, block)
+		if n > 0 {
+			id := blockId(len(blockIds))
+
+			if _, stageErr := blob.StageBlock(
+				ctx,
+				id,
+				bytes.NewReader(block[:n]),
+				azblob.LeaseAccessConditions{},
+				nil,
+				azblob.ClientProvidedKeyOptions{},
+			); stageErr != nil {
+				return newErr(
+					codes.Internal,
+					"Unable to write blob data",
+					stageErr,
+				)
+			}
+
+			blockIds = append(blockIds, id)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				"Error receiving blob data",
+				err,
+			)
+		}
+	}
+
+	if _, err := blob.CommitBlockList(
+		ctx,
+		blockIds,
+		azblob.BlobHTTPHeaders{
+			ContentType:  contentType,
+			CacheControl: metadata.CacheControl,
+		},
+		blobMetadata(metadata.Metadata),
+		azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier,
+		nil,
+		azblob.ClientProvidedKeyOptions{},
+	); err != nil {
+		return newErr(
+			codes.Internal,
+			"Unable to write blob data",
+			err,
+		)
+	}
+
+	return stream.SendAndClose(&storagepb.StorageWriteResponse{})
+}
+
 func (a *AzblobStorageService) Delete(ctx context.Context, req *storagepb.StorageDeleteRequest) (*storagepb.StorageDeleteResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Delete")
 
@@ -138,79 +283,245 @@ func (a *AzblobStorageService) Delete(ctx context.Context, req *storagepb.Storag
 	return &storagepb.StorageDeleteResponse{}, nil
 }
 
+// signedBlobUrl returns the URL of a blob, signed with a user delegation SAS granting the given permissions until the expiry
+func (s *AzblobStorageService) signedBlobUrl(ctx context.Context, bucket string, key string, expiry time.Duration, permissions azblob.BlobSASPermissions) (url.URL, error) {
+	blobUrlParts := azblob.NewBlobURLParts(s.getBlobUrl(bucket, key).Url())
+	currentTime := time.Now().UTC()
+	validDuration := currentTime.Add(expiry)
+	cred, err := s.client.GetUserDelegationCredential(ctx, azblob.NewKeyInfo(currentTime, validDuration), nil, nil)
+	if err != nil {
+		return url.URL{}, fmt.Errorf("could not get user delegation credential: %w", err)
+	}
+
+	sigOpts := azblob.BlobSASSignatureValues{
+		Protocol:      azblob.SASProtocolHTTPS,
+		ExpiryTime:    validDuration,
+		Permissions:   permissions.String(),
+		BlobName:      key,
+		ContainerName: bucket,
+	}
+
+	queryParams, err := sigOpts.NewSASQueryParameters(cred)
+	if err != nil {
+		return url.URL{}, fmt.Errorf("error signing query params for URL: %w", err)
+	}
+
+	blobUrlParts.SAS = queryParams
+
+	return blobUrlParts.URL(), nil
+}
+
 func (s *AzblobStorageService) PreSignUrl(ctx context.Context, req *storagepb.StoragePreSignUrlRequest) (*storagepb.StoragePreSignUrlResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.PreSignUrl")
 
-	blobUrlParts := azblob.NewBlobURLParts(s.getBlobUrl(req.BucketName, req.Key).Url())
-	currentTime := time.Now().UTC()
-	validDuration := currentTime.Add(req.Expiry.AsDuration())
-	cred, err := s.client.GetUserDelegationCredential(ctx, azblob.NewKeyInfo(currentTime, validDuration), nil, nil)
+	url, err := s.signedBlobUrl(ctx, req.BucketName, req.Key, req.Expiry.AsDuration(), azblob.BlobSASPermissions{
+		Read:  req.Operation == storagepb.StoragePreSignUrlRequest_READ,
+		Write: req.Operation == storagepb.StoragePreSignUrlRequest_WRITE,
+	})
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
-			"could not get user delegation credential",
+			"unable to sign blob URL",
 			err,
 		)
 	}
 
-	sigOpts := azblob.BlobSASSignatureValues{
-		Protocol:   azblob.SASProtocolHTTPS,
-		ExpiryTime: validDuration,
-		Permissions: azblob.BlobSASPermissions{
-			Read:  req.Operation == storagepb.StoragePreSignUrlRequest_READ,
-			Write: req.Operation == storagepb.StoragePreSignUrlRequest_WRITE,
-		}.String(),
-		BlobName:      req.Key,
-		ContainerName: req.BucketName,
+	return &storagepb.StoragePreSignUrlResponse{
+		Url: url.String(),
+	}, nil
+}
+
+const (
+	// copySourceExpiry is how long the storage service can read the source blob of a copy for
+	copySourceExpiry = time.Hour
+	// copyPollInterval is how often the status of a pending copy is checked
+	copyPollInterval = 500 * time.Millisecond
+)
+
+func (s *AzblobStorageService) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Copy")
+
+	// the storage service reads the source blob itself, so it's given a short lived read-only SAS URL
+	source, err := s.signedBlobUrl(ctx, req.SourceBucketName, req.SourceKey, copySourceExpiry, azblob.BlobSASPermissions{
+		Read: true,
+	})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to sign source blob URL",
+			err,
+		)
+	}
+
+	destination := s.getBlobUrl(req.DestinationBucketName, req.DestinationKey)
+
+	// empty metadata copies the metadata of the source blob, along with its properties
+	resp, err := destination.StartCopyFromURL(
+		ctx,
+		source,
+		azblob.Metadata{},
+		azblob.ModifiedAccessConditions{},
+		azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier,
+		nil,
+	)
+
+	//nolint:all
+	if storageErr, ok := err.(azblob.StorageError); ok {
+		switch storageErr.ServiceCode() {
+		case azblob.ServiceCodeBlobNotFound, azblob.ServiceCodeCannotVerifyCopySource:
+			return nil, newErr(codes.NotFound, fmt.Sprintf("blob %s not found", req.SourceKey), err)
+		}
 	}
 
-	queryParams, err := sigOpts.NewSASQueryParameters(cred)
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
-			"error signing query params for URL",
+			"Unable to copy blob",
 			err,
 		)
 	}
 
-	blobUrlParts.SAS = queryParams
-	url := blobUrlParts.URL()
+	// copies within a storage account usually complete immediately, others complete in the background
+	status := resp.CopyStatus()
+	for status == azblob.CopyStatusPending {
+		select {
+		case <-ctx.Done():
+			return nil, newErr(codes.DeadlineExceeded, "Copy did not complete", ctx.Err())
+		case <-time.After(copyPollInterval):
+		}
 
-	return &storagepb.StoragePreSignUrlResponse{
-		Url: url.String(),
-	}, nil
+		props, err := destination.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+		if err != nil {
+			return nil, newErr(codes.Internal, "error getting blob copy status", err)
+		}
+
+		status = props.CopyStatus()
+	}
+
+	if status != azblob.CopyStatusSuccess {
+		return nil, newErr(
+			codes.Internal,
+			"Unable to copy blob",
+			fmt.Errorf("copy status %s", status),
+		)
+	}
+
+	return &storagepb.StorageCopyResponse{}, nil
+}
+
+func (s *AzblobStorageService) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	if _, err := s.Copy(ctx, &storagepb.StorageCopyRequest{
+		SourceBucketName:      req.SourceBucketName,
+		SourceKey:             req.SourceKey,
+		DestinationBucketName: req.DestinationBucketName,
+		DestinationKey:        req.DestinationKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	// moving a blob onto itself leaves it in place
+	if req.SourceBucketName == req.DestinationBucketName && req.SourceKey == req.DestinationKey {
+		return &storagepb.StorageMoveResponse{}, nil
+	}
+
+	if _, err := s.Delete(ctx, &storagepb.StorageDeleteRequest{
+		BucketName: req.SourceBucketName,
+		Key:        req.SourceKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StorageMoveResponse{}, nil
 }
 
 func (s *AzblobStorageService) ListBlobs(ctx context.Context, req *storagepb.StorageListBlobsRequest) (*storagepb.StorageListBlobsResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.ListFiles")
 
+	if req.PageSize < 0 {
+		return nil, newErr(codes.InvalidArgument, "page size cannot be negative", nil)
+	}
+
 	cUrl := s.getContainerUrl(req.BucketName)
-	files := make([]*storagepb.Blob, 0)
+	resp := &storagepb.StorageListBlobsResponse{
+		Blobs:    []*storagepb.Blob{},
+		Prefixes: []string{},
+	}
+
+	opts := azblob.ListBlobsSegmentOptions{
+		Prefix: req.Prefix,
+		Details: azblob.BlobListingDetails{
+			Metadata: true,
+		},
+		MaxResults: req.PageSize,
+	}
+
+	marker := azblob.Marker{}
+	if req.PageToken != "" {
+		marker.Val = &req.PageToken
+	}
 
 	// List the blob(s) in our container; since a container may hold millions of blobs, this is done 1 segment at a time.
-	for marker := (azblob.Marker{}); marker.NotDone(); { // The parens around Marker{} are required to avoid compiler error.
+	for marker.NotDone() {
+		var blobItems []azblob.BlobItemInternal
+
 		// Get a result segment starting with the blob indicated by the current Marker.
-		listBlob, err := cUrl.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{
-			Prefix: req.Prefix,
-		})
-		if err != nil {
-			return nil, newErr(codes.Internal, "error listing files", err)
+		if req.Delimiter != "" {
+			listBlob, err := cUrl.ListBlobsHierarchySegment(ctx, marker, req.Delimiter, opts)
+			if err != nil {
+				return nil, newErr(codes.Internal, "error listing files", err)
+			}
+
+			for _, prefix := range listBlob.Segment.BlobPrefixes {
+				resp.Prefixes = append(resp.Prefixes, prefix.Name)
+			}
+
+			blobItems = listBlob.Segment.BlobItems
+			marker = listBlob.NextMarker
+		} else {
+			listBlob, err := cUrl.ListBlobsFlatSegment(ctx, marker, opts)
+			if err != nil {
+				return nil, newErr(codes.Internal, "error listing files", err)
+			}
+
+			blobItems = listBlob.Segment.BlobItems
+			// IMPORTANT: ListBlobs returns the start of the next segment; you MUST use this to get
+			// the next segment (after processing the current result segment).
+			marker = listBlob.NextMarker
 		}
-		// IMPORTANT: ListBlobs returns the start of the next segment; you MUST use this to get
-		// the next segment (after processing the current result segment).
-		marker = listBlob.NextMarker
 
 		// Process the blobs returned in this result segment (if the segment is empty, the loop body won't execute)
-		for _, blobInfo := range listBlob.Segment.BlobItems {
-			files = append(files, &storagepb.Blob{
-				Key: blobInfo.Name,
-			})
+		for _, blobInfo := range blobItems {
+			blob := &storagepb.Blob{
+				Key:          blobInfo.Name,
+				Etag:         unquoteETag(blobInfo.Properties.Etag),
+				LastModified: timestamppb.New(blobInfo.Properties.LastModified),
+				Metadata:     blobInfo.Metadata,
+			}
+
+			if blobInfo.Properties.ContentLength != nil {
+				blob.Size = *blobInfo.Properties.ContentLength
+			}
+			if blobInfo.Properties.ContentType != nil {
+				blob.ContentType = *blobInfo.Properties.ContentType
+			}
+			if blobInfo.Properties.CacheControl != nil {
+				blob.CacheControl = *blobInfo.Properties.CacheControl
+			}
+
+			resp.Blobs = append(resp.Blobs, blob)
+		}
+
+		// return a single segment when paging, the marker resumes listing from the next one
+		if req.PageSize > 0 {
+			if marker.Val != nil {
+				resp.NextPageToken = *marker.Val
+			}
+			break
 		}
 	}
 
-	return &storagepb.StorageListBlobsResponse{
-		Blobs: files,
-	}, nil
+	return resp, nil
 }
 
 func (s *AzblobStorageService) Exists(ctx context.Context, req *storagepb.StorageExistsRequest) (*storagepb.StorageExistsResponse, error) {
@@ -239,6 +550,52 @@ func (s *AzblobStorageService) Exists(ctx context.Context, req *storagepb.Storag
 	}, nil
 }
 
+func (s *AzblobStorageService) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Stat")
+
+	bUrl := s.getBlobUrl(req.BucketName, req.Key)
+
+	props, err := bUrl.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+
+	//nolint:all
+	if storageErr, ok := err.(azblob.StorageError); ok {
+		if storageErr.ServiceCode() == azblob.ServiceCodeBlobNotFound {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("blob %s not found", req.Key), err)
+		}
+	}
+
+	if err != nil {
+		return nil, newErr(codes.Internal, "error getting blob properties", err)
+	}
+
+	return &storagepb.StorageStatResponse{
+		Blob: &storagepb.Blob{
+			Key:          req.Key,
+			Size:         props.ContentLength(),
+			Etag:         unquoteETag(props.ETag()),
+			LastModified: timestamppb.New(props.LastModified()),
+			ContentType:  props.ContentType(),
+			CacheControl: props.CacheControl(),
+			Metadata:     props.NewMetadata(),
+		},
+	}, nil
+}
+
+// blobMetadata copies user metadata into the azblob metadata type, which is never nil
+func blobMetadata(m map[string]string) azblob.Metadata {
+	metadata := azblob.Metadata{}
+	for k, v := range m {
+		metadata[k] = v
+	}
+
+	return metadata
+}
+
+// unquoteETag removes the quotes Azure includes in ETags
+func unquoteETag(etag azblob.ETag) string {
+	return strings.Trim(string(etag), `"`)
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azblob.TokenRefresher {
@@ -25,6 +25,9 @@ import (
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	. "github.com/onsi/ginkgo"
@@ -34,6 +37,48 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// mockReadStream collects the chunks sent by ReadStream
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+// mockWriteStream sends the given messages to WriteStream
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+	closed   bool
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(*storagepb.StorageWriteResponse) error {
+	m.closed = true
+	return nil
+}
+
 var _ = Describe("Azblob", func() {
 	// Context("New", func() {
 	//	When("", func() {
@@ -337,6 +382,66 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 
+		When("Listing a page of files with a delimiter", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			pageToken := "page-1"
+			nextMarker := "page-2"
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return a single segment of files and prefixes", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("The container returning a segment of the hierarchy")
+				mockContainer.EXPECT().ListBlobsHierarchySegment(gomock.Any(), azblob.Marker{Val: &pageToken}, "/", azblob.ListBlobsSegmentOptions{
+					Details: azblob.BlobListingDetails{
+						Metadata: true,
+					},
+					MaxResults: 2,
+				}).Times(1).Return(&azblob.ListBlobsHierarchySegmentResponse{
+					NextMarker: azblob.Marker{
+						Val: &nextMarker,
+					},
+					Segment: azblob.BlobHierarchyListSegment{
+						BlobPrefixes: []azblob.BlobPrefix{
+							{
+								Name: "test/",
+							},
+						},
+						BlobItems: []azblob.BlobItemInternal{
+							{
+								Name: "test.png",
+							},
+						},
+					},
+				}, nil)
+
+				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Delimiter:  "/",
+					PageSize:   2,
+					PageToken:  "page-1",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the files and prefixes")
+				Expect(resp.Blobs).To(HaveLen(1))
+				Expect(resp.Prefixes).To(Equal([]string{"test/"}))
+
+				By("Returning the marker for the next segment")
+				Expect(resp.NextPageToken).To(Equal("page-2"))
+
+				ctrl.Finish()
+			})
+		})
+
 		When("Azure returns an error", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
@@ -578,4 +683,219 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 	})
+
+	Context("Copy", func() {
+		When("the copy completes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockSourceBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDestinationBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockCopyResponse := mock_azblob.NewMockAzblobCopyResponse(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should copy the blob from a signed source URL", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(2).Return(mockContainer)
+
+				By("Retrieving the blob urls of the source and destination")
+				mockContainer.EXPECT().NewBlockBlobURL("test-file").Times(1).Return(mockSourceBlob)
+				mockContainer.EXPECT().NewBlockBlobURL("copied-file").Times(1).Return(mockDestinationBlob)
+
+				By("Signing the source URL")
+				mockAzblob.EXPECT().GetUserDelegationCredential(
+					gomock.Any(), gomock.Any(), gomock.Any(), nil,
+				).Return(
+					azblob.NewUserDelegationCredential("mock-account-name", azblob.UserDelegationKey{}),
+					nil,
+				)
+
+				u, _ := url.Parse("https://fake-account.com/my-bucket/test-file")
+				mockSourceBlob.EXPECT().Url().Return(*u)
+
+				By("Starting the copy")
+				var source url.URL
+				mockDestinationBlob.EXPECT().StartCopyFromURL(
+					gomock.Any(), gomock.Any(), azblob.Metadata{}, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(1).Do(func(ctx context.Context, u url.URL, m azblob.Metadata, srcac azblob.ModifiedAccessConditions, dstac azblob.BlobAccessConditions, att azblob.AccessTierType, btm azblob.BlobTagsMap) {
+					source = u
+				}).Return(mockCopyResponse, nil)
+
+				By("The copy completing immediately")
+				mockCopyResponse.EXPECT().CopyStatus().Return(azblob.CopyStatusSuccess)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "test-file",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "copied-file",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Copying from a read-only signed URL")
+				Expect(source.Host).To(Equal("fake-account.com"))
+				Expect(source.Query().Get("sp")).To(Equal("r"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Stat", func() {
+		When("the file does not exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockError := mock_azblob.NewMockStorageError(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return a not found error", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("Retrieving the Blob URL for the requested file")
+				mockContainer.EXPECT().NewBlockBlobURL("test-file").Times(1).Return(mockBlob)
+
+				By("Producing a service code of azblob.ServiceCodeBlobNotFound")
+				mockError.EXPECT().ServiceCode().Times(1).Return(azblob.ServiceCodeBlobNotFound)
+				mockError.EXPECT().Error().AnyTimes()
+
+				By("Calling GetProperties on the file")
+				mockBlob.EXPECT().GetProperties(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, mockError)
+
+				resp, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "my-bucket",
+					Key:        "test-file",
+				})
+
+				By("Returning a not found error")
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+				By("Returning nil")
+				Expect(resp).To(BeNil())
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("ReadStream", func() {
+		When("Reading a byte range of a blob", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(crtl)
+			mockDown := mock_azblob.NewMockAzblobDownloadResponse(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should stream the requested range", func() {
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				By("Downloading the requested range")
+				mockBlob.EXPECT().Download(
+					gomock.Any(),
+					int64(5),
+					int64(8),
+					azblob.BlobAccessConditions{},
+					false,
+					azblob.ClientProvidedKeyOptions{},
+				).Times(1).Return(mockDown, nil)
+
+				mockDown.EXPECT().Body(gomock.Any()).Times(1).Return(io.NopCloser(strings.NewReader("contents")))
+
+				stream := &mockReadStream{}
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "my-blob",
+					Offset:     5,
+					Length:     8,
+				}, stream)
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Sending the range")
+				Expect(bytes.Join(stream.chunks, nil)).To(BeEquivalentTo([]byte("contents")))
+
+				crtl.Finish()
+			})
+		})
+	})
+
+	Context("WriteStream", func() {
+		When("Azure returns a successful response", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should stage and commit the streamed blob", func() {
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("my-blob.txt").Times(1).Return(mockBlob)
+
+				By("Staging the body as a block")
+				var staged []byte
+				mockBlob.EXPECT().StageBlock(
+					gomock.Any(),
+					blockId(0),
+					gomock.Any(),
+					azblob.LeaseAccessConditions{},
+					nil,
+					azblob.ClientProvidedKeyOptions{},
+				).Times(1).DoAndReturn(func(_ context.Context, _ string, r io.ReadSeeker, _ azblob.LeaseAccessConditions, _ []byte, _ azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobStageBlockResponse, error) {
+					staged, _ = io.ReadAll(r)
+					return &azblob.BlockBlobStageBlockResponse{}, nil
+				})
+
+				By("Committing the staged block")
+				mockBlob.EXPECT().CommitBlockList(
+					gomock.Any(),
+					[]string{blockId(0)},
+					azblob.BlobHTTPHeaders{
+						ContentType: "text/plain; charset=utf-8",
+					},
+					azblob.Metadata{},
+					azblob.BlobAccessConditions{},
+					azblob.DefaultAccessTier,
+					nil,
+					azblob.ClientProvidedKeyOptions{},
+				).Times(1).Return(&azblob.BlockBlobCommitBlockListResponse{}, nil)
+
+				stream := &mockWriteStream{requests: []*storagepb.StorageWriteStreamRequest{
+					{Content: &storagepb.StorageWriteStreamRequest_Metadata{Metadata: &storagepb.StorageWriteStreamMetadata{
+						BucketName: "my-bucket",
+						Key:        "my-blob.txt",
+					}}},
+					{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("file-")}},
+					{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("contents")}},
+				}}
+
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stream.closed).To(BeTrue())
+				Expect(staged).To(BeEquivalentTo([]byte("file-contents")))
+
+				crtl.Finish()
+			})
+		})
+	})
 })
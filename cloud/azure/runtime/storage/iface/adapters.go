@@ -58,6 +58,10 @@ func (c containerUrl) ListBlobsFlatSegment(ctx context.Context, marker azblob.Ma
 	return c.c.ListBlobsFlatSegment(ctx, marker, o)
 }
 
+func (c containerUrl) ListBlobsHierarchySegment(ctx context.Context, marker azblob.Marker, delimiter string, o azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsHierarchySegmentResponse, error) {
+	return c.c.ListBlobsHierarchySegment(ctx, marker, delimiter, o)
+}
+
 func (c blobUrl) Download(ctx context.Context, offset int64, count int64, bac azblob.BlobAccessConditions, f bool, cpk azblob.ClientProvidedKeyOptions) (AzblobDownloadResponse, error) {
 	return c.c.Download(ctx, offset, count, bac, f, cpk)
 }
@@ -70,6 +74,14 @@ func (c blobUrl) Upload(ctx context.Context, r io.ReadSeeker, h azblob.BlobHTTPH
 	return c.c.Upload(ctx, r, h, m, bac, att, btm, cpk, azblob.ImmutabilityPolicyOptions{})
 }
 
+func (c blobUrl) StageBlock(ctx context.Context, id string, r io.ReadSeeker, lac azblob.LeaseAccessConditions, md5 []byte, cpk azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobStageBlockResponse, error) {
+	return c.c.StageBlock(ctx, id, r, lac, md5, cpk)
+}
+
+func (c blobUrl) CommitBlockList(ctx context.Context, ids []string, h azblob.BlobHTTPHeaders, m azblob.Metadata, bac azblob.BlobAccessConditions, att azblob.AccessTierType, btm azblob.BlobTagsMap, cpk azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobCommitBlockListResponse, error) {
+	return c.c.CommitBlockList(ctx, ids, h, m, bac, att, btm, cpk, azblob.ImmutabilityPolicyOptions{})
+}
+
 func (c blobUrl) Delete(ctx context.Context, dot azblob.DeleteSnapshotsOptionType, bac azblob.BlobAccessConditions) (*azblob.BlobDeleteResponse, error) {
 	return c.c.Delete(ctx, dot, bac)
 }
@@ -77,3 +89,7 @@ func (c blobUrl) Delete(ctx context.Context, dot azblob.DeleteSnapshotsOptionTyp
 func (c blobUrl) GetProperties(ctx context.Context, bac azblob.BlobAccessConditions, cpk azblob.ClientProvidedKeyOptions) (*azblob.BlobGetPropertiesResponse, error) {
 	return c.c.GetProperties(ctx, bac, cpk)
 }
+
+func (c blobUrl) StartCopyFromURL(ctx context.Context, source url.URL, m azblob.Metadata, srcac azblob.ModifiedAccessConditions, dstac azblob.BlobAccessConditions, att azblob.AccessTierType, btm azblob.BlobTagsMap) (AzblobCopyResponse, error) {
+	return c.c.StartCopyFromURL(ctx, source, m, srcac, dstac, att, btm)
+}
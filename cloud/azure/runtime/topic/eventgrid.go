@@ -69,6 +69,14 @@ func (s *EventGridEventService) Publish(ctx context.Context, req *topicpb.TopicP
 		return nil, newErr(codes.Unimplemented, "delayed messages with eventgrid are unsupported", nil)
 	}
 
+	if len(req.Attributes) > 0 {
+		return nil, newErr(codes.Unimplemented, "message attributes with eventgrid are unsupported", nil)
+	}
+
+	if req.OrderingKey != "" {
+		return nil, newErr(codes.Unimplemented, "ordered messages with eventgrid are unsupported", nil)
+	}
+
 	topics, err := s.provider.GetResources(ctx, resource.AzResource_Topic)
 	if err != nil {
 		return nil, newErr(
@@ -118,6 +126,85 @@ func (s *EventGridEventService) Publish(ctx context.Context, req *topicpb.TopicP
 	return &topicpb.TopicPublishResponse{}, nil
 }
 
+// PublishBatch publishes multiple messages to a given topic in a single event grid post
+func (s *EventGridEventService) PublishBatch(ctx context.Context, req *topicpb.TopicPublishBatchRequest) (*topicpb.TopicPublishBatchResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("EventGrid.PublishBatch")
+
+	topics, err := s.provider.GetResources(ctx, resource.AzResource_Topic)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("unable to find topic %s", req.TopicName),
+			err,
+		)
+	}
+
+	t, ok := topics[req.TopicName]
+	if !ok {
+		return nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("topic %s does not exist", req.TopicName),
+			err,
+		)
+	}
+
+	topicHostName := fmt.Sprintf("%s.%s-1.eventgrid.azure.net", t.Name, t.Location)
+
+	failedMessages := []*topicpb.FailedPublishMessage{}
+	published := []*topicpb.TopicBatchMessage{}
+	eventsToPublish := []eventgrid.Event{}
+
+	for _, message := range req.Messages {
+		if len(message.Attributes) > 0 || message.OrderingKey != "" {
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: message,
+				Details: "message attributes and ordered messages with eventgrid are unsupported",
+			})
+			continue
+		}
+
+		eventToPublish, err := s.nitricEventToAzureEvent(topicHostName, message.Message)
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"error marshalling event",
+				err,
+			)
+		}
+
+		published = append(published, message)
+		eventsToPublish = append(eventsToPublish, *eventToPublish)
+	}
+
+	if len(eventsToPublish) == 0 {
+		return &topicpb.TopicPublishBatchResponse{
+			FailedMessages: failedMessages,
+		}, nil
+	}
+
+	// event grid accepts or rejects the events of a post together, so a failure applies to every message
+	details := ""
+	result, err := s.client.PublishEvents(ctx, topicHostName, eventsToPublish)
+	if err != nil {
+		details = err.Error()
+	} else if result.StatusCode < 200 || result.StatusCode >= 300 {
+		details = fmt.Sprintf("returned non 200 status code: %s", result.Status)
+	}
+
+	if details != "" {
+		for _, message := range published {
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: message,
+				Details: details,
+			})
+		}
+	}
+
+	return &topicpb.TopicPublishBatchResponse{
+		FailedMessages: failedMessages,
+	}, nil
+}
+
 func New(provider resource.AzResourceResolver) (*EventGridEventService, error) {
 	// Get the event grid token, using the event grid resource endpoint
 	spt, err := provider.ServicePrincipalToken("https://eventgrid.azure.net")
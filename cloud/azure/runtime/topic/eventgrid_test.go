@@ -23,6 +23,8 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	mock_eventgrid "github.com/nitrictech/nitric/cloud/azure/mocks/mock_event_grid"
 	mock_provider "github.com/nitrictech/nitric/cloud/azure/mocks/provider"
@@ -128,5 +130,70 @@ var _ = Describe("Event Grid Plugin", func() {
 				ctrl.Finish()
 			})
 		})
+
+		When("Publishing with attributes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			eventgridClient := mock_eventgrid.NewMockBaseClientAPI(ctrl)
+			mockProvider := mock_provider.NewMockAzResourceResolver(ctrl)
+			eventgridPlugin, _ := eventgrid_service.NewWithClient(mockProvider, eventgridClient)
+
+			It("should return an unimplemented error", func() {
+				_, err := eventgridPlugin.Publish(context.TODO(), &topicpb.TopicPublishRequest{
+					TopicName:  mockTopicName,
+					Message:    eventPayload,
+					Attributes: map[string]string{"region": "australiaeast"},
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	When("Publishing a batch of messages", func() {
+		eventPayload := &topicpb.TopicMessage{}
+
+		When("Some messages have attributes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			eventgridClient := mock_eventgrid.NewMockBaseClientAPI(ctrl)
+			mockProvider := mock_provider.NewMockAzResourceResolver(ctrl)
+			eventgridPlugin, _ := eventgrid_service.NewWithClient(mockProvider, eventgridClient)
+
+			withAttributes := &topicpb.TopicBatchMessage{
+				Message:    eventPayload,
+				Attributes: map[string]string{"region": "australiaeast"},
+			}
+
+			It("should publish the other messages in a single post", func() {
+				By("the az provider returning topics")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AzResource_Topic).Return(getTopicResourcesResponse, nil)
+
+				By("the eventgrid client publishing both supported messages")
+				eventgridClient.EXPECT().PublishEvents(
+					gomock.Any(),
+					fmt.Sprintf("%s.%s-1.eventgrid.azure.net", getTopicResourcesResponse["Test"].Name, getTopicResourcesResponse["Test"].Location),
+					gomock.Len(2),
+				).Return(autorest.Response{
+					Response: &http.Response{
+						StatusCode: 202,
+					},
+				}, nil).Times(1)
+
+				resp, err := eventgridPlugin.PublishBatch(context.TODO(), &topicpb.TopicPublishBatchRequest{
+					TopicName: "Test",
+					Messages: []*topicpb.TopicBatchMessage{
+						{Message: eventPayload},
+						withAttributes,
+						{Message: eventPayload},
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(HaveLen(1))
+				Expect(resp.FailedMessages[0].Message).To(Equal(withAttributes))
+
+				ctrl.Finish()
+			})
+		})
 	})
 })
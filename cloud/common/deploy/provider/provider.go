@@ -104,7 +104,42 @@ func (*NitricDefaultOrder) Order(resources []*deploymentspb.Resource) []*deploym
 
 	sorted := []*deploymentspb.Resource{}
 	for _, resourceType := range typeOrder {
-		sorted = append(sorted, just(resources, resourceType)...)
+		resourcesOfType := just(resources, resourceType)
+		if resourceType == resourcespb.ResourceType_Queue {
+			resourcesOfType = orderQueues(resourcesOfType)
+		}
+
+		sorted = append(sorted, resourcesOfType...)
+	}
+
+	return sorted
+}
+
+// orderQueues - orders queues so that dead letter queues are deployed before the queues that use them
+func orderQueues(queues []*deploymentspb.Resource) []*deploymentspb.Resource {
+	byName := lo.KeyBy(queues, func(queue *deploymentspb.Resource) string {
+		return queue.Id.Name
+	})
+
+	sorted := make([]*deploymentspb.Resource, 0, len(queues))
+	visited := map[string]bool{}
+
+	var visit func(queue *deploymentspb.Resource)
+	visit = func(queue *deploymentspb.Resource) {
+		if visited[queue.Id.Name] {
+			return
+		}
+		visited[queue.Id.Name] = true
+
+		if deadLetterQueue, ok := byName[queue.GetQueue().GetDeadLetter().GetQueueName()]; ok {
+			visit(deadLetterQueue)
+		}
+
+		sorted = append(sorted, queue)
+	}
+
+	for _, queue := range queues {
+		visit(queue)
 	}
 
 	return sorted
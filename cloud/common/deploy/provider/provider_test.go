@@ -0,0 +1,78 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider_test
+
+import (
+	"github.com/nitrictech/nitric/cloud/common/deploy/provider"
+	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func queueResource(name string, deadLetterQueue string) *deploymentspb.Resource {
+	queue := &deploymentspb.Queue{}
+	if deadLetterQueue != "" {
+		queue.DeadLetter = &deploymentspb.QueueDeadLetter{
+			MaxReceives: 5,
+			QueueName:   deadLetterQueue,
+		}
+	}
+
+	return &deploymentspb.Resource{
+		Id: &resourcespb.ResourceIdentifier{
+			Name: name,
+			Type: resourcespb.ResourceType_Queue,
+		},
+		Config: &deploymentspb.Resource_Queue{
+			Queue: queue,
+		},
+	}
+}
+
+func names(resources []*deploymentspb.Resource) []string {
+	resourceNames := []string{}
+	for _, res := range resources {
+		resourceNames = append(resourceNames, res.Id.Name)
+	}
+	return resourceNames
+}
+
+var _ = Describe("NitricDefaultOrder", func() {
+	order := &provider.NitricDefaultOrder{}
+
+	When("Queues use dead letter queues", func() {
+		It("should order dead letter queues before the queues that use them", func() {
+			sorted := order.Order([]*deploymentspb.Resource{
+				queueResource("orders", "orders-retry"),
+				queueResource("orders-retry", "orders-dead"),
+				queueResource("emails", ""),
+				queueResource("orders-dead", ""),
+			})
+
+			Expect(names(sorted)).To(Equal([]string{"orders-dead", "orders-retry", "orders", "emails"}))
+		})
+	})
+
+	When("A queue uses itself as its dead letter queue", func() {
+		It("should still include the queue once", func() {
+			sorted := order.Order([]*deploymentspb.Resource{
+				queueResource("orders", "orders"),
+			})
+
+			Expect(names(sorted)).To(Equal([]string{"orders"}))
+		})
+	})
+})
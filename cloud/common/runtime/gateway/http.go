@@ -29,6 +29,7 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 type (
@@ -79,6 +80,16 @@ func HttpHeadersToMap(rh *fasthttp.RequestHeader) map[string][]string {
 	return headerCopy
 }
 
+// WorkerErrorStatus returns the HTTP status code for an error returned by a worker request handler,
+// 504 if the worker didn't respond before the request deadline, otherwise 500.
+func WorkerErrorStatus(err error) int {
+	if workers.IsWorkerTimeout(err) {
+		return fasthttp.StatusGatewayTimeout
+	}
+
+	return fasthttp.StatusInternalServerError
+}
+
 func (s *HttpGateway) newApiHandler(opts *gateway.GatewayStartOpts, apiNameParam string, originalPathParam string) func(ctx *fasthttp.RequestCtx) {
 	return func(rc *fasthttp.RequestCtx) {
 		// The API name is captured in the path using a path rewrite at the cloud API Gateway layer, and used to route the request to the correct workers
@@ -121,9 +132,9 @@ func (s *HttpGateway) newApiHandler(opts *gateway.GatewayStartOpts, apiNameParam
 			},
 		}
 
-		resp, err := opts.ApiPlugin.HandleRequest(apiName, httpTrigger)
+		resp, err := opts.ApiPlugin.HandleRequest(rc, apiName, httpTrigger)
 		if err != nil {
-			rc.Error("Unable to get worker to handle request", 500)
+			rc.Error("Unable to get worker to handle request", WorkerErrorStatus(err))
 			return
 		}
 
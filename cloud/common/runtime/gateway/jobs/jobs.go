@@ -15,6 +15,7 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -42,7 +43,7 @@ func (s *DefaultBatchGateway) Start(opts *gateway.GatewayStartOpts) error {
 	}
 
 	// construct the job event
-	response, err := opts.JobHandlerPlugin.HandleJobRequest(&batchpb.ServerMessage{
+	response, err := opts.JobHandlerPlugin.HandleJobRequest(context.Background(), &batchpb.ServerMessage{
 		Content: &batchpb.ServerMessage_JobRequest{
 			JobRequest: &batchpb.JobRequest{
 				JobName: jobName,
@@ -0,0 +1,110 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+// StreamChunkSize - the maximum size of the chunks blobs are streamed in
+const StreamChunkSize = 64 * 1024
+
+// ErrMissingWriteStreamMetadata - returned when a write stream doesn't start with the metadata of the blob to write
+var ErrMissingWriteStreamMetadata = errors.New("the first message of a write stream must contain the blob metadata")
+
+// SendChunks - reads r until EOF, sending its contents to the read stream in chunks of at most StreamChunkSize bytes
+func SendChunks(r io.Reader, stream storagepb.Storage_ReadStreamServer) error {
+	buf := make([]byte, StreamChunkSize)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&storagepb.StorageReadStreamResponse{
+				Chunk: append([]byte(nil), buf[:n]...),
+			}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// WriteStreamReader - reads the chunks of a blob body from a write stream
+type WriteStreamReader struct {
+	stream storagepb.Storage_WriteStreamServer
+	chunk  []byte
+}
+
+var _ io.Reader = (*WriteStreamReader)(nil)
+
+func (w *WriteStreamReader) Read(p []byte) (int, error) {
+	for len(w.chunk) == 0 {
+		req, err := w.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetMetadata() != nil {
+			return 0, errors.New("blob metadata can only be sent in the first message of a write stream")
+		}
+
+		w.chunk = req.GetChunk()
+	}
+
+	n := copy(p, w.chunk)
+	w.chunk = w.chunk[n:]
+
+	return n, nil
+}
+
+// NewWriteStreamReader - receives the metadata from the first message of a write stream, returning it with a reader for the blob body that follows
+func NewWriteStreamReader(stream storagepb.Storage_WriteStreamServer) (*storagepb.StorageWriteStreamMetadata, *WriteStreamReader, error) {
+	req, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, ErrMissingWriteStreamMetadata
+		}
+
+		return nil, nil, err
+	}
+
+	metadata := req.GetMetadata()
+	if metadata == nil {
+		return nil, nil, ErrMissingWriteStreamMetadata
+	}
+
+	return metadata, &WriteStreamReader{stream: stream}, nil
+}
+
+// DetectStreamContentType - detects the content type of a streamed file using its extension or the start of its content.
+// Returns the content type and a reader that still includes the inspected content.
+func DetectStreamContentType(filename string, r io.Reader) (string, io.Reader) {
+	// http.DetectContentType considers at most the first 512 bytes
+	buffered := bufio.NewReaderSize(r, 512)
+	head, _ := buffered.Peek(512)
+
+	return DetectContentType(filename, head), buffered
+}
@@ -62,6 +62,11 @@ func (p *NitricGcpPulumiProvider) newCloudStorageNotification(ctx *pulumi.Contex
 		return fmt.Errorf("invalid config provided for bucket notification")
 	}
 
+	eventTypes := notificationTypeToStorageEventType(listener.Config.BlobEventType)
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("blob event type %s is not supported by cloud storage notifications", listener.Config.BlobEventType)
+	}
+
 	topic, err := pubsub.NewTopic(ctx, name+"-topic", &pubsub.TopicArgs{
 		Labels: pulumi.ToStringMap(common.Tags(p.StackId, name, resources.Bucket)),
 	}, opts...)
@@ -126,7 +131,7 @@ func (p *NitricGcpPulumiProvider) newCloudStorageNotification(ctx *pulumi.Contex
 		Bucket:           targetBucket.Name,
 		PayloadFormat:    pulumi.String("JSON_API_V1"),
 		Topic:            topic.ID(),
-		EventTypes:       pulumi.ToStringArray(notificationTypeToStorageEventType(listener.Config.BlobEventType)),
+		EventTypes:       pulumi.ToStringArray(eventTypes),
 		ObjectNamePrefix: pulumi.String(prefix),
 	}, p.WithDefaultResourceOptions(append(opts, pulumi.DependsOn([]pulumi.Resource{binding}))...)...)
 	if err != nil {
@@ -136,12 +141,16 @@ func (p *NitricGcpPulumiProvider) newCloudStorageNotification(ctx *pulumi.Contex
 	return nil
 }
 
+// notificationTypeToStorageEventType returns the cloud storage notification event types for a blob event type.
+// Restored blobs are not supported, cloud storage reports them as newly finalized objects.
 func notificationTypeToStorageEventType(eventType storagepb.BlobEventType) []string {
 	switch eventType {
 	case storagepb.BlobEventType_Created:
 		return []string{"OBJECT_FINALIZE"}
 	case storagepb.BlobEventType_Deleted:
 		return []string{"OBJECT_DELETE"}
+	case storagepb.BlobEventType_MetadataUpdated:
+		return []string{"OBJECT_METADATA_UPDATE"}
 	default:
 		return []string{}
 	}
@@ -103,6 +103,7 @@ type NitricGcpPulumiProvider struct {
 	privateNetwork       *compute.Network
 	privateSubnet        *compute.Subnetwork
 	vpcConnector         *vpcaccess.Connector
+	firestoreDatabase    *firestore.Database
 
 	provider.NitricDefaultOrder
 }
@@ -300,7 +301,7 @@ func (a *NitricGcpPulumiProvider) Pre(ctx *pulumi.Context, resources []*pulumix.
 	})
 
 	if kvStoreExists {
-		err := createFirestoreDatabase(ctx, *project.ProjectId, a.Region)
+		a.firestoreDatabase, err = createFirestoreDatabase(ctx, *project.ProjectId, a.Region)
 		if err != nil {
 			return err
 		}
@@ -471,10 +472,10 @@ func NewNitricGcpProvider() *NitricGcpPulumiProvider {
 	}
 }
 
-func createFirestoreDatabase(ctx *pulumi.Context, projectId string, location string) error {
+func createFirestoreDatabase(ctx *pulumi.Context, projectId string, location string) (*firestore.Database, error) {
 	fsAdminClient, err := apiv1.NewFirestoreAdminClient(context.TODO())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defaultDb, _ := fsAdminClient.GetDatabase(context.TODO(), &adminpb.GetDatabaseRequest{
@@ -484,23 +485,15 @@ func createFirestoreDatabase(ctx *pulumi.Context, projectId string, location str
 	defaultFirestoreId := pulumi.ID("(default)")
 
 	if defaultDb != nil {
-		_, err = firestore.GetDatabase(ctx, "default", defaultFirestoreId, nil)
-		if err != nil {
-			return err
-		}
-	} else {
-		_, err = firestore.NewDatabase(ctx, "default", &firestore.DatabaseArgs{
-			Name:                     defaultFirestoreId,
-			AppEngineIntegrationMode: pulumi.String("DISABLED"),
-			LocationId:               pulumi.String(location),
-			Type:                     pulumi.String("FIRESTORE_NATIVE"),
-		}, pulumi.RetainOnDelete(true))
-		if err != nil {
-			return err
-		}
+		return firestore.GetDatabase(ctx, "default", defaultFirestoreId, nil)
 	}
 
-	return nil
+	return firestore.NewDatabase(ctx, "default", &firestore.DatabaseArgs{
+		Name:                     defaultFirestoreId,
+		AppEngineIntegrationMode: pulumi.String("DISABLED"),
+		LocationId:               pulumi.String(location),
+		Type:                     pulumi.String("FIRESTORE_NATIVE"),
+	}, pulumi.RetainOnDelete(true))
 }
 
 func (a *NitricGcpPulumiProvider) createCloudSQLDatabase(ctx *pulumi.Context) error {
@@ -16,10 +16,24 @@ package deploy
 
 import (
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	"github.com/pulumi/pulumi-gcp/sdk/v8/go/gcp/firestore"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 func (p *NitricGcpPulumiProvider) KeyValueStore(ctx *pulumi.Context, parent pulumi.Resource, name string, config *deploymentspb.KeyValueStore) error {
-	// keyvalue stores are created at runtime in GCP, so we don't need to do anything here.
-	return nil
+	// keyvalue stores are created at runtime in GCP, only the TTL policy for expiring values is deployed here.
+	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
+	if p.firestoreDatabase != nil {
+		opts = append(opts, pulumi.DependsOn([]pulumi.Resource{p.firestoreDatabase}))
+	}
+
+	_, err := firestore.NewField(ctx, name+"-ttl", &firestore.FieldArgs{
+		Project:    pulumi.String(p.GcpConfig.ProjectId),
+		Database:   pulumi.String("(default)"),
+		Collection: pulumi.String(name),
+		Field:      pulumi.String("_expiry"),
+		TtlConfig:  &firestore.FieldTtlConfigArgs{},
+	}, opts...)
+
+	return err
 }
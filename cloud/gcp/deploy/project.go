@@ -24,6 +24,8 @@ type Project struct {
 
 	Name     string
 	Services []*projects.Service
+	// The IAM member of the google managed pubsub service account
+	PubsubServiceAccount pulumi.StringOutput
 }
 
 type ProjectArgs struct {
@@ -104,6 +106,7 @@ func NewProject(ctx *pulumi.Context, name string, args *ProjectArgs, opts ...pul
 	// Add ServiceAccount Token Creator Role to the default pubsub gservice account
 	// services-{projectNumber}@gcp-sa-pubsub.iam.gserviceaccount.com
 	serviceAccount := pulumi.Sprintf("serviceAccount:service-%s@gcp-sa-pubsub.iam.gserviceaccount.com", args.ProjectNumber)
+	res.PubsubServiceAccount = serviceAccount
 
 	_, err = projects.NewIAMMember(ctx, "pubsub-token-creator", &projects.IAMMemberArgs{
 		Role:    pulumi.String("roles/iam.serviceAccountTokenCreator"),
@@ -27,6 +27,12 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// Pub/Sub limits on the number of delivery attempts before a message is dead lettered
+const (
+	minDeliveryAttempts = 5
+	maxDeliveryAttempts = 100
+)
+
 func (p *NitricGcpPulumiProvider) Queue(ctx *pulumi.Context, parent pulumi.Resource, name string, config *deploymentspb.Queue) error {
 	var err error
 	opts := append([]pulumi.ResourceOption{}, pulumi.Parent(parent))
@@ -40,16 +46,57 @@ func (p *NitricGcpPulumiProvider) Queue(ctx *pulumi.Context, parent pulumi.Resou
 		return err
 	}
 
-	p.QueueSubscriptions[name], err = pubsub.NewSubscription(ctx, fmt.Sprintf("%s-nitricqueue", name), &pubsub.SubscriptionArgs{
+	subscriptionArgs := &pubsub.SubscriptionArgs{
 		Topic:  p.Queues[name].Name,
 		Labels: pulumi.ToStringMap(resourceLabels),
 		ExpirationPolicy: &pubsub.SubscriptionExpirationPolicyArgs{
 			Ttl: pulumi.String(""),
 		},
-	}, p.WithDefaultResourceOptions(opts...)...)
+	}
+
+	deadLetter := config.GetDeadLetter()
+	if deadLetter != nil {
+		if deadLetter.MaxReceives < minDeliveryAttempts || deadLetter.MaxReceives > maxDeliveryAttempts {
+			return fmt.Errorf("queue %s max receives must be between %d and %d, got %d", name, minDeliveryAttempts, maxDeliveryAttempts, deadLetter.MaxReceives)
+		}
+
+		deadLetterTopic, ok := p.Queues[deadLetter.QueueName]
+		if !ok {
+			return fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+		}
+
+		subscriptionArgs.DeadLetterPolicy = &pubsub.SubscriptionDeadLetterPolicyArgs{
+			DeadLetterTopic:     deadLetterTopic.ID(),
+			MaxDeliveryAttempts: pulumi.Int(int(deadLetter.MaxReceives)),
+		}
+	}
+
+	p.QueueSubscriptions[name], err = pubsub.NewSubscription(ctx, fmt.Sprintf("%s-nitricqueue", name), subscriptionArgs, p.WithDefaultResourceOptions(opts...)...)
 	if err != nil {
 		return err
 	}
 
+	if deadLetter != nil {
+		// The pubsub service account forwards undeliverable messages, so it must be able to publish them to the
+		// dead letter topic and acknowledge them on the queue's subscription
+		_, err = pubsub.NewTopicIAMMember(ctx, fmt.Sprintf("%s-deadletter-publisher", name), &pubsub.TopicIAMMemberArgs{
+			Topic:  p.Queues[deadLetter.QueueName].Name,
+			Role:   pulumi.String("roles/pubsub.publisher"),
+			Member: p.Project.PubsubServiceAccount,
+		}, p.WithDefaultResourceOptions(opts...)...)
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.NewSubscriptionIAMMember(ctx, fmt.Sprintf("%s-deadletter-subscriber", name), &pubsub.SubscriptionIAMMemberArgs{
+			Subscription: p.QueueSubscriptions[name].Name,
+			Role:         pulumi.String("roles/pubsub.subscriber"),
+			Member:       p.Project.PubsubServiceAccount,
+		}, p.WithDefaultResourceOptions(opts...)...)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -36,6 +36,9 @@ import (
 )
 
 // NitricCloudRunService - A wrapper that encapsulates all important information about a cloud run service deployed by nitric
+// healthPort is the port the membrane serves its health endpoints on
+const healthPort = 9002
+
 type NitricCloudRunService struct {
 	Name           string
 	Service        *cloudrunv2.Service
@@ -158,6 +161,10 @@ func (p *NitricGcpPulumiProvider) Service(ctx *pulumi.Context, parent pulumi.Res
 			Name:  pulumi.String("NITRIC_HTTP_PROXY_PORT"),
 			Value: pulumi.String(fmt.Sprint(3000)),
 		},
+		cloudrunv2.ServiceTemplateContainerEnvArgs{
+			Name:  pulumi.String("HEALTH_ADDRESS"),
+			Value: pulumi.Sprintf(":%d", healthPort),
+		},
 	}
 
 	if p.JobDefinitionBucket != nil {
@@ -229,6 +236,24 @@ func (p *NitricGcpPulumiProvider) Service(ctx *pulumi.Context, parent pulumi.Res
 				Resources: cloudrunv2.ServiceTemplateContainerResourcesArgs{
 					Limits: pulumi.ToStringMap(limits),
 				},
+				// The container is started once the membrane is ready to receive requests
+				StartupProbe: cloudrunv2.ServiceTemplateContainerStartupProbeArgs{
+					HttpGet: cloudrunv2.ServiceTemplateContainerStartupProbeHttpGetArgs{
+						Path: pulumi.String("/readyz"),
+						Port: pulumi.Int(healthPort),
+					},
+					PeriodSeconds:    pulumi.Int(1),
+					FailureThreshold: pulumi.Int(240),
+				},
+				// The container is restarted if the child process or membrane services stop
+				LivenessProbe: cloudrunv2.ServiceTemplateContainerLivenessProbeArgs{
+					HttpGet: cloudrunv2.ServiceTemplateContainerLivenessProbeHttpGetArgs{
+						Path: pulumi.String("/livez"),
+						Port: pulumi.Int(healthPort),
+					},
+					PeriodSeconds:    pulumi.Int(10),
+					FailureThreshold: pulumi.Int(3),
+				},
 			},
 		},
 		NodeSelector: nodeSelector,
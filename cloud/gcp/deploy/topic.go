@@ -105,6 +105,8 @@ func (p *NitricGcpPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 				MinimumBackoff: pulumi.String("15s"),
 				MaximumBackoff: pulumi.String("600s"),
 			},
+			// deliver messages published with the same ordering key in order
+			EnableMessageOrdering: pulumi.Bool(true),
 			PushConfig: pubsub.SubscriptionPushConfigArgs{
 				OidcToken: pubsub.SubscriptionPushConfigOidcTokenArgs{
 					ServiceAccountEmail: targetService.Invoker.Email,
@@ -17,8 +17,35 @@ resource "google_pubsub_subscription" "queue_subscription" {
     ttl = ""
   }
 
+  dynamic "dead_letter_policy" {
+    for_each = var.max_delivery_attempts == null ? [] : [1]
+    content {
+      dead_letter_topic     = var.dead_letter_topic_id
+      max_delivery_attempts = var.max_delivery_attempts
+    }
+  }
+
   labels = {
     "x-nitric-${var.stack_id}-name" = var.queue_name
     "x-nitric-${var.stack_id}-type" = "queue"
   }
-}
\ No newline at end of file
+}
+
+# Get the GCP project number
+data "google_project" "project" {
+}
+
+# The pubsub service account forwards undeliverable messages to the dead letter topic
+resource "google_pubsub_topic_iam_member" "dead_letter_publisher" {
+  count  = var.max_delivery_attempts == null ? 0 : 1
+  topic  = var.dead_letter_topic_id
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_pubsub_subscription_iam_member" "dead_letter_subscriber" {
+  count        = var.max_delivery_attempts == null ? 0 : 1
+  subscription = google_pubsub_subscription.queue_subscription.name
+  role         = "roles/pubsub.subscriber"
+  member       = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
@@ -1,4 +1,9 @@
 output "name" {
   description = "The name of the deployed queue."
   value       = google_pubsub_subscription.queue_subscription.name
-}
\ No newline at end of file
+}
+
+output "topic_id" {
+  description = "The ID of the topic messages are published to"
+  value       = google_pubsub_topic.queue.id
+}
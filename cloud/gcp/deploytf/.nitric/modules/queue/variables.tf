@@ -6,4 +6,16 @@ variable "queue_name" {
 variable "stack_id" {
   description = "The ID of the Nitric stack"
   type        = string
-}
\ No newline at end of file
+}
+
+variable "dead_letter_topic_id" {
+  description = "The ID of the topic that receives messages delivered more than max_delivery_attempts times"
+  type        = string
+  default     = null
+}
+
+variable "max_delivery_attempts" {
+  description = "The number of times a message can be delivered before it's moved to the dead letter topic, messages are never dead lettered if null"
+  type        = number
+  default     = null
+}
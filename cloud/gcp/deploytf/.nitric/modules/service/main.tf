@@ -109,6 +109,30 @@ resource "google_cloud_run_v2_service" "service" {
         name  = "GCP_REGION"
         value = var.region
       }
+      env {
+        name  = "HEALTH_ADDRESS"
+        value = ":9002"
+      }
+
+      # The container is started once the membrane is ready to receive requests
+      startup_probe {
+        http_get {
+          path = "/readyz"
+          port = 9002
+        }
+        period_seconds    = 1
+        failure_threshold = 240
+      }
+
+      # The container is restarted if the child process or membrane services stop
+      liveness_probe {
+        http_get {
+          path = "/livez"
+          port = 9002
+        }
+        period_seconds    = 10
+        failure_threshold = 3
+      }
 
       dynamic "env" {
         for_each = var.environment
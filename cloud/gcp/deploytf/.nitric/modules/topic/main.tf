@@ -13,6 +13,8 @@ resource "google_pubsub_subscription" "topic_subscriptions" {
   name = "${var.subscriber_services[count.index].name}"
   topic = google_pubsub_topic.topic.name
   ack_deadline_seconds = 300
+  # deliver messages published with the same ordering key in order
+  enable_message_ordering = true
 
   retry_policy {
     minimum_backoff = "15s"
@@ -15,6 +15,8 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/gcp/deploytf/generated/bucket"
@@ -22,6 +24,8 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// eventsForBlobEventType returns the cloud storage notification event types for a blob event type.
+// Restored blobs are not supported, cloud storage reports them as newly finalized objects.
 func eventsForBlobEventType(blobEventType storagepb.BlobEventType) []string {
 	switch blobEventType {
 	case storagepb.BlobEventType_Created:
@@ -32,6 +36,10 @@ func eventsForBlobEventType(blobEventType storagepb.BlobEventType) []string {
 		return []string{
 			"OBJECT_DELETE",
 		}
+	case storagepb.BlobEventType_MetadataUpdated:
+		return []string{
+			"OBJECT_METADATA_UPDATE",
+		}
 	default:
 		return []string{}
 	}
@@ -52,12 +60,17 @@ func (n *NitricGcpTerraformProvider) Bucket(stack cdktf.TerraformStack, name str
 	notificationTargets := map[string]*NotifiedService{}
 
 	for _, target := range config.Listeners {
+		events := eventsForBlobEventType(target.Config.BlobEventType)
+		if len(events) == 0 {
+			return fmt.Errorf("blob event type %s is not supported by cloud storage notifications", target.Config.BlobEventType)
+		}
+
 		notificationTargets[target.GetService()] = &NotifiedService{
 			Name:                       target.GetService(),
 			Url:                        *n.Services[target.GetService()].ServiceEndpointOutput(),
 			InvokerServiceAccountEmail: *n.Services[target.GetService()].InvokerServiceAccountEmailOutput(),
 			EventToken:                 *n.Services[target.GetService()].EventTokenOutput(),
-			Events:                     eventsForBlobEventType(target.Config.BlobEventType),
+			Events:                     events,
 			Prefix:                     target.Config.KeyPrefixFilter,
 		}
 	}
@@ -15,11 +15,22 @@
 package deploytf
 
 import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdktf/cdktf-provider-google-go/google/v14/firestorefield"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 )
 
 func (a *NitricGcpTerraformProvider) KeyValueStore(stack cdktf.TerraformStack, name string, config *deploymentspb.KeyValueStore) error {
-	// NoOp - Key Value Store are created at runtime on GCP
+	// Key Value Stores are created at runtime on GCP, only the TTL policy for expiring values is deployed here
+	firestorefield.NewFirestoreField(stack, jsii.Sprintf("kvstore_%s_ttl", name), &firestorefield.FirestoreFieldConfig{
+		Project:    jsii.String(a.GcpConfig.ProjectId),
+		Database:   jsii.String("(default)"),
+		Collection: jsii.String(name),
+		Field:      jsii.String("_expiry"),
+		TtlConfig:  &firestorefield.FirestoreFieldTtlConfig{},
+		DependsOn:  &[]cdktf.ITerraformDependable{a.Stack},
+	})
+
 	return nil
 }
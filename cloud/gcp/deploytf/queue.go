@@ -15,18 +15,43 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/gcp/deploytf/generated/queue"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 )
 
+// Pub/Sub limits on the number of delivery attempts before a message is dead lettered
+const (
+	minDeliveryAttempts = 5
+	maxDeliveryAttempts = 100
+)
+
 // // Queue - Deploy a Queue
 func (a *NitricGcpTerraformProvider) Queue(stack cdktf.TerraformStack, name string, config *deploymentspb.Queue) error {
-	a.Queues[name] = queue.NewQueue(stack, jsii.Sprintf("queue_%s", name), &queue.QueueConfig{
+	q := queue.NewQueue(stack, jsii.Sprintf("queue_%s", name), &queue.QueueConfig{
 		QueueName: jsii.String(name),
 		StackId:   a.Stack.StackIdOutput(),
 	})
 
+	if deadLetter := config.GetDeadLetter(); deadLetter != nil {
+		if deadLetter.MaxReceives < minDeliveryAttempts || deadLetter.MaxReceives > maxDeliveryAttempts {
+			return fmt.Errorf("queue %s max receives must be between %d and %d, got %d", name, minDeliveryAttempts, maxDeliveryAttempts, deadLetter.MaxReceives)
+		}
+
+		deadLetterQueue, ok := a.Queues[deadLetter.QueueName]
+		if !ok {
+			return fmt.Errorf("dead letter queue %s for queue %s not found", deadLetter.QueueName, name)
+		}
+
+		// the dead letter inputs are optional module variables, so they're set directly on the module
+		q.AddOverride(jsii.String("dead_letter_topic_id"), deadLetterQueue.GetString(jsii.String("topic_id")))
+		q.AddOverride(jsii.String("max_delivery_attempts"), jsii.Number(deadLetter.MaxReceives))
+	}
+
+	a.Queues[name] = q
+
 	return nil
 }
@@ -77,6 +77,11 @@ func (o objectHandle) NewReader(ctx context.Context) (Reader, error) {
 	return reader{newReader}, err
 }
 
+func (o objectHandle) NewRangeReader(ctx context.Context, offset int64, length int64) (Reader, error) {
+	newReader, err := o.ObjectHandle.NewRangeReader(ctx, offset, length)
+	return reader{newReader}, err
+}
+
 func (o objectHandle) Delete(ctx context.Context) error {
 	return o.ObjectHandle.Delete(ctx)
 }
@@ -84,3 +89,8 @@ func (o objectHandle) Delete(ctx context.Context) error {
 func (o objectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
 	return o.ObjectHandle.Attrs(ctx)
 }
+
+// CopierFrom only accepts source objects created by this adapter
+func (o objectHandle) CopierFrom(src ObjectHandle) Copier {
+	return o.ObjectHandle.CopierFrom(src.(objectHandle).ObjectHandle)
+}
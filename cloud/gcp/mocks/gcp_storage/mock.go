@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/cloud/gcp/ifaces/gcloud_storage (interfaces: Reader,Writer,ObjectHandle,BucketHandle,BucketIterator,StorageClient,ObjectIterator)
+// Source: github.com/nitrictech/nitric/cloud/gcp/ifaces/gcloud_storage (interfaces: Reader,Writer,ObjectHandle,BucketHandle,BucketIterator,StorageClient,ObjectIterator,Copier)
 
 // Package mock_gcloud_storage is a generated GoMock package.
 package mock_gcloud_storage
@@ -11,6 +11,7 @@ import (
 	storage "cloud.google.com/go/storage"
 	gomock "github.com/golang/mock/gomock"
 	ifaces_gcloud_storage "github.com/nitrictech/nitric/cloud/gcp/ifaces/gcloud_storage"
+	iterator "google.golang.org/api/iterator"
 )
 
 // MockReader is a mock of Reader interface.
@@ -169,6 +170,20 @@ func (mr *MockObjectHandleMockRecorder) Attrs(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attrs", reflect.TypeOf((*MockObjectHandle)(nil).Attrs), arg0)
 }
 
+// CopierFrom mocks base method.
+func (m *MockObjectHandle) CopierFrom(arg0 ifaces_gcloud_storage.ObjectHandle) ifaces_gcloud_storage.Copier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopierFrom", arg0)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.Copier)
+	return ret0
+}
+
+// CopierFrom indicates an expected call of CopierFrom.
+func (mr *MockObjectHandleMockRecorder) CopierFrom(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopierFrom", reflect.TypeOf((*MockObjectHandle)(nil).CopierFrom), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockObjectHandle) Delete(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -183,6 +198,21 @@ func (mr *MockObjectHandleMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockObjectHandle)(nil).Delete), arg0)
 }
 
+// NewRangeReader mocks base method.
+func (m *MockObjectHandle) NewRangeReader(arg0 context.Context, arg1, arg2 int64) (ifaces_gcloud_storage.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRangeReader", arg0, arg1, arg2)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRangeReader indicates an expected call of NewRangeReader.
+func (mr *MockObjectHandleMockRecorder) NewRangeReader(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRangeReader", reflect.TypeOf((*MockObjectHandle)(nil).NewRangeReader), arg0, arg1, arg2)
+}
+
 // NewReader mocks base method.
 func (m *MockObjectHandle) NewReader(arg0 context.Context) (ifaces_gcloud_storage.Reader, error) {
 	m.ctrl.T.Helper()
@@ -404,3 +434,55 @@ func (mr *MockObjectIteratorMockRecorder) Next() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockObjectIterator)(nil).Next))
 }
+
+// PageInfo mocks base method.
+func (m *MockObjectIterator) PageInfo() *iterator.PageInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageInfo")
+	ret0, _ := ret[0].(*iterator.PageInfo)
+	return ret0
+}
+
+// PageInfo indicates an expected call of PageInfo.
+func (mr *MockObjectIteratorMockRecorder) PageInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageInfo", reflect.TypeOf((*MockObjectIterator)(nil).PageInfo))
+}
+
+// MockCopier is a mock of Copier interface.
+type MockCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCopierMockRecorder
+}
+
+// MockCopierMockRecorder is the mock recorder for MockCopier.
+type MockCopierMockRecorder struct {
+	mock *MockCopier
+}
+
+// NewMockCopier creates a new mock instance.
+func NewMockCopier(ctrl *gomock.Controller) *MockCopier {
+	mock := &MockCopier{ctrl: ctrl}
+	mock.recorder = &MockCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCopier) EXPECT() *MockCopierMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockCopier) Run(arg0 context.Context) (*storage.ObjectAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0)
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockCopierMockRecorder) Run(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCopier)(nil).Run), arg0)
+}
@@ -182,6 +182,18 @@ func (mr *MockTopicMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockTopic)(nil).Publish), arg0, arg1)
 }
 
+// ResumePublish mocks base method.
+func (m *MockTopic) ResumePublish(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResumePublish", arg0)
+}
+
+// ResumePublish indicates an expected call of ResumePublish.
+func (mr *MockTopicMockRecorder) ResumePublish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumePublish", reflect.TypeOf((*MockTopic)(nil).ResumePublish), arg0)
+}
+
 // String mocks base method.
 func (m *MockTopic) String() string {
 	m.ctrl.T.Helper()
@@ -35,9 +35,14 @@ func (g *GcpApiGatewayProvider) ApiDetails(ctx context.Context, req *apispb.ApiD
 		return nil, err
 	}
 
-	return &apispb.ApiDetailsResponse{
-		Url: gwDetails.Url,
-	}, nil
+	details, err := g.RouteWorkerManager.ApiDetails(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	details.Url = gwDetails.Url
+
+	return details, nil
 }
 
 func NewGcpApiGatewayProvider(provider resource.GcpResourceResolver) *GcpApiGatewayProvider {
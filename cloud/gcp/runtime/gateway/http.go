@@ -19,7 +19,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/propagator"
 	"github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
 
@@ -41,13 +43,36 @@ type gcpMiddleware struct {
 
 type PubSubMessage struct {
 	Message struct {
-		Attributes map[string]string `json:"attributes"`
-		Data       []byte            `json:"data,omitempty"`
-		ID         string            `json:"id"`
+		Attributes  map[string]string `json:"attributes"`
+		Data        []byte            `json:"data,omitempty"`
+		ID          string            `json:"id"`
+		OrderingKey string            `json:"orderingKey"`
 	} `json:"message"`
 	Subscription string `json:"subscription"`
 }
 
+// StorageObject is the subset of the JSON_API_V1 object payload of a cloud storage notification included in blob events
+type StorageObject struct {
+	Size        string `json:"size"`
+	Etag        string `json:"etag"`
+	ContentType string `json:"contentType"`
+}
+
+// messageAttributes returns the attributes a message was published with, without those added by nitric for delivery and tracing
+func messageAttributes(attributes map[string]string) map[string]string {
+	attrs := map[string]string{}
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+
+	delete(attrs, "x-nitric-topic")
+	for _, field := range (propagator.CloudTraceFormatPropagator{}).Fields() {
+		delete(attrs, field)
+	}
+
+	return attrs
+}
+
 func eventAuthorised(ctx *fasthttp.RequestCtx) bool {
 	token := ctx.QueryArgs().Peek("token")
 	evtToken := os.Getenv("EVENT_TOKEN")
@@ -82,37 +107,43 @@ func (g *gcpMiddleware) handleSubscription(opts *gateway.GatewayStartOpts) fasth
 				fmt.Println("could not parse message as a nitric event attempting to parse as generic json payload")
 
 				messageData := map[string]any{}
-				err := json.Unmarshal(pubsubEvent.Message.Data, &messageData)
-				if err != nil {
-					ctx.Error("could not unmarshal event data", 500)
-					return
-				}
-
-				structPayload, err := structpb.NewStruct(messageData)
-				if err != nil {
-					ctx.Error("could not convert message data to struct", 500)
-					return
-				}
-
-				message = topicpb.TopicMessage{
-					Content: &topicspb.TopicMessage_StructPayload{
-						StructPayload: structPayload,
-					},
+				if err := json.Unmarshal(pubsubEvent.Message.Data, &messageData); err == nil {
+					structPayload, err := structpb.NewStruct(messageData)
+					if err != nil {
+						ctx.Error("could not convert message data to struct", 500)
+						return
+					}
+
+					message = topicpb.TopicMessage{
+						Content: &topicspb.TopicMessage_StructPayload{
+							StructPayload: structPayload,
+						},
+					}
+				} else {
+					// messages published directly to the topic by other sources can contain any data, so it's delivered unchanged
+					message = topicpb.TopicMessage{
+						Content: &topicspb.TopicMessage_BytesPayload{
+							BytesPayload: pubsubEvent.Message.Data,
+						},
+						ContentType: "application/octet-stream",
+					}
 				}
 			}
 
 			event := &topicspb.ServerMessage{
 				Content: &topicspb.ServerMessage_MessageRequest{
 					MessageRequest: &topicspb.MessageRequest{
-						TopicName: topicName,
-						Message:   &message,
+						TopicName:   topicName,
+						Message:     &message,
+						Attributes:  messageAttributes(pubsubEvent.Message.Attributes),
+						OrderingKey: pubsubEvent.Message.OrderingKey,
 					},
 				},
 			}
 
-			response, err := opts.TopicsListenerPlugin.HandleRequest(event)
+			response, err := opts.TopicsListenerPlugin.HandleRequest(ctx, event)
 			if err != nil {
-				ctx.Error(fmt.Sprintf("Error handling event %v", err), 500)
+				ctx.Error(fmt.Sprintf("Error handling event %v", err), base_http.WorkerErrorStatus(err))
 				return
 			}
 
@@ -138,7 +169,7 @@ func (g *gcpMiddleware) handleSchedule(opts *gateway.GatewayStartOpts) fasthttp.
 			ctx.Error("Can not handle event for empty schedule", 400)
 		}
 
-		_, err := opts.SchedulesPlugin.HandleRequest(&schedulespb.ServerMessage{
+		_, err := opts.SchedulesPlugin.HandleRequest(ctx, &schedulespb.ServerMessage{
 			Content: &schedulespb.ServerMessage_IntervalRequest{
 				IntervalRequest: &schedulespb.IntervalRequest{
 					ScheduleName: scheduleName,
@@ -147,7 +178,7 @@ func (g *gcpMiddleware) handleSchedule(opts *gateway.GatewayStartOpts) fasthttp.
 		})
 		if err != nil {
 			logger.Errorf("could not handle trigger for schedule %s: %s", scheduleName, err.Error())
-			ctx.Error("could not handle trigger", 500)
+			ctx.Error("could not handle trigger", base_http.WorkerErrorStatus(err))
 			return
 		}
 
@@ -162,6 +193,8 @@ func notificationEventToEventType(eventType string) (*storagepb.BlobEventType, e
 		return storagepb.BlobEventType_Created.Enum(), nil
 	case "OBJECT_DELETE":
 		return storagepb.BlobEventType_Deleted.Enum(), nil
+	case "OBJECT_METADATA_UPDATE":
+		return storagepb.BlobEventType_MetadataUpdated.Enum(), nil
 	default:
 		return nil, fmt.Errorf("unsupported bucket notification event type %s", eventType)
 	}
@@ -188,21 +221,31 @@ func (g *gcpMiddleware) handleBucketNotification(opts *gateway.GatewayStartOpts)
 				return
 			}
 
-			resp, err := opts.StorageListenerPlugin.HandleRequest(&storagepb.ServerMessage{
+			blobEvent := &storagepb.BlobEvent{
+				Key:  key,
+				Type: *eventType,
+			}
+
+			// the object payload is best effort, the event is still delivered without it
+			var object StorageObject
+			if err := json.Unmarshal(pubsubEvent.Message.Data, &object); err == nil {
+				blobEvent.Size, _ = strconv.ParseInt(object.Size, 10, 64)
+				blobEvent.Etag = object.Etag
+				blobEvent.ContentType = object.ContentType
+			}
+
+			resp, err := opts.StorageListenerPlugin.HandleRequest(ctx, &storagepb.ServerMessage{
 				Content: &storagepb.ServerMessage_BlobEventRequest{
 					BlobEventRequest: &storagepb.BlobEventRequest{
 						BucketName: bucketName,
 						Event: &storagepb.BlobEventRequest_BlobEvent{
-							BlobEvent: &storagepb.BlobEvent{
-								Key:  key,
-								Type: *eventType,
-							},
+							BlobEvent: blobEvent,
 						},
 					},
 				},
 			})
 			if err != nil {
-				ctx.Error(fmt.Sprintf("Error handling event %v", err), 500)
+				ctx.Error(fmt.Sprintf("Error handling event %v", err), base_http.WorkerErrorStatus(err))
 				return
 			}
 
@@ -82,7 +82,7 @@ var _ = Describe("Http", func() {
 				var capturedApiName string
 
 				By("Handling exactly 1 request")
-				mockApiRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}, arg1 interface{}) (*apispb.ClientMessage, error) {
+				mockApiRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}, arg1 interface{}) (*apispb.ClientMessage, error) {
 					capturedApiName = arg0.(string)
 					capturedRequest = arg1.(*apispb.ServerMessage)
 					// apiName string, request *apispb.ServerMessage
@@ -177,7 +177,7 @@ var _ = Describe("Http", func() {
 				var capturedRequest *topicspb.ServerMessage
 
 				By("Handling exactly 1 request")
-				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}) (*topicspb.ClientMessage, error) {
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
 					capturedRequest = arg0.(*topicspb.ServerMessage)
 
 					return &topicspb.ClientMessage{
@@ -238,7 +238,7 @@ var _ = Describe("Http", func() {
 				var capturedRequest *topicspb.ServerMessage
 
 				By("Handling exactly 1 request")
-				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}) (*topicspb.ClientMessage, error) {
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
 					capturedRequest = arg0.(*topicspb.ServerMessage)
 
 					return &topicspb.ClientMessage{
@@ -272,5 +272,116 @@ var _ = Describe("Http", func() {
 				Expect(string(responseBody)).To(Equal("success"))
 			})
 		})
+
+		When("From a subscription with a non JSON event", func() {
+			messageBytes := []byte("not json")
+
+			b64Event := base64.StdEncoding.EncodeToString(messageBytes)
+			payloadBytes, _ := json.Marshal(&map[string]interface{}{
+				"subscription": "test",
+				"message": map[string]interface{}{
+					"attributes": map[string]string{
+						"x-nitric-topic": "test",
+					},
+					"id":   "test",
+					"data": b64Event,
+				},
+			})
+
+			It("Should handle the event as a bytes payload", func() {
+				var capturedRequest *topicspb.ServerMessage
+
+				By("Handling exactly 1 request")
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
+					capturedRequest = arg0.(*topicspb.ServerMessage)
+
+					return &topicspb.ClientMessage{
+						Id: "test",
+						Content: &topicspb.ClientMessage_MessageResponse{
+							MessageResponse: &topicspb.MessageResponse{
+								Success: true,
+							},
+						},
+					}, nil
+				})
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-topic/test", gatewayUrl), bytes.NewReader(payloadBytes))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				By("Having the original payload passed through unchanged")
+				capturedMessage := capturedRequest.GetMessageRequest().GetMessage()
+				Expect(capturedMessage.GetBytesPayload()).To(Equal(messageBytes))
+				Expect(capturedMessage.GetContentType()).To(Equal("application/octet-stream"))
+
+				By("The request returns a successful status")
+				Expect(resp.StatusCode).To(Equal(200))
+			})
+		})
+
+		When("From a subscription with attributes and an ordering key", func() {
+			content, _ := structpb.NewStruct(map[string]interface{}{
+				"Test": "Test",
+			})
+
+			message := topicspb.TopicMessage{
+				Content: &topicspb.TopicMessage_StructPayload{
+					StructPayload: content,
+				},
+			}
+
+			messageBytes, err := proto.Marshal(&message)
+			Expect(err).To(BeNil())
+
+			b64Event := base64.StdEncoding.EncodeToString(messageBytes)
+			payloadBytes, _ := json.Marshal(&map[string]interface{}{
+				"subscription": "test",
+				"message": map[string]interface{}{
+					"attributes": map[string]string{
+						"x-nitric-topic":        "test",
+						"X-Cloud-Trace-Context": "105445aa7843bc8bf206b12000100000/1;o=1",
+						"region":                "australia-southeast1",
+					},
+					"id":          "test",
+					"data":        b64Event,
+					"orderingKey": "customer-1",
+				},
+			})
+
+			It("Should pass the published attributes and ordering key to subscribers", func() {
+				var capturedRequest *topicspb.ServerMessage
+
+				By("Handling exactly 1 request")
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
+					capturedRequest = arg0.(*topicspb.ServerMessage)
+
+					return &topicspb.ClientMessage{
+						Id: "test",
+						Content: &topicspb.ClientMessage_MessageResponse{
+							MessageResponse: &topicspb.MessageResponse{
+								Success: true,
+							},
+						},
+					}, nil
+				})
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-topic/test", gatewayUrl), bytes.NewReader(payloadBytes))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				By("Removing the attributes added by nitric")
+				Expect(capturedRequest.GetMessageRequest().GetAttributes()).To(Equal(map[string]string{
+					"region": "australia-southeast1",
+				}))
+				Expect(capturedRequest.GetMessageRequest().GetOrderingKey()).To(Equal("customer-1"))
+
+				By("The request returns a successful status")
+				Expect(resp.StatusCode).To(Equal(200))
+			})
+		})
 	})
 })
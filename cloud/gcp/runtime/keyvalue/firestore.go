@@ -18,7 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/nitrictech/nitric/core/pkg/decorators/keyvalue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
@@ -34,6 +35,66 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// AttribExpiry is the field of the collection's TTL policy, holding the time an expiring value expires at
+const AttribExpiry = "_expiry"
+
+var errPreconditionFailed = errors.New("precondition failed")
+
+// expired returns true if the document data has an expiry that has passed
+func expired(data map[string]interface{}, now time.Time) bool {
+	expiry, ok := data[AttribExpiry].(time.Time)
+	return ok && !now.Before(expiry)
+}
+
+// version returns the version of a document, which is its last update time
+func version(snapshot *firestore.DocumentSnapshot) string {
+	return strconv.FormatInt(snapshot.UpdateTime.UnixNano(), 10)
+}
+
+// valueFromSnapshot converts a document snapshot to a value, returning nil if the document doesn't exist or has expired
+func valueFromSnapshot(ref *v1.ValueRef, snapshot *firestore.DocumentSnapshot, now time.Time) (*v1.Value, error) {
+	if !snapshot.Exists() {
+		return nil, nil
+	}
+
+	data := snapshot.Data()
+
+	// Firestore removes expired documents eventually, so they may still be returned for some time after expiring
+	if expired(data, now) {
+		return nil, nil
+	}
+
+	delete(data, AttribExpiry)
+
+	content, err := structpb.NewStruct(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Value{
+		Ref:     ref,
+		Content: content,
+		Version: version(snapshot),
+	}, nil
+}
+
+// batchErr converts the error of a batched read or write to the error reported for its key
+func batchErr(newErr grpc_errors.ScopedErrorFactory, err error) error {
+	if status.Code(err) == codes.PermissionDenied {
+		return newErr(
+			codes.PermissionDenied,
+			"permission denied, have you requested access to this key value store?",
+			err,
+		)
+	}
+
+	return newErr(
+		codes.Internal,
+		"unable to complete batch request",
+		err,
+	)
+}
+
 type FirestoreDocService struct {
 	client *firestore.Client
 }
@@ -77,7 +138,7 @@ func (s *FirestoreDocService) GetValue(ctx context.Context, req *v1.KvStoreGetVa
 		)
 	}
 
-	documentContent, err := structpb.NewStruct(value.Data())
+	documentValue, err := valueFromSnapshot(req.Ref, value, time.Now())
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -86,11 +147,16 @@ func (s *FirestoreDocService) GetValue(ctx context.Context, req *v1.KvStoreGetVa
 		)
 	}
 
+	if documentValue == nil {
+		return nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("key %s not found in store %s", req.Ref.Key, req.Ref.Store),
+			nil,
+		)
+	}
+
 	return &v1.KvStoreGetValueResponse{
-		Value: &v1.Value{
-			Ref:     req.Ref,
-			Content: documentContent,
-		},
+		Value: documentValue,
 	}, nil
 }
 
@@ -113,9 +179,64 @@ func (s *FirestoreDocService) SetValue(ctx context.Context, req *v1.KvStoreSetVa
 		)
 	}
 
+	if err := keyvalue.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid ttl",
+			err,
+		)
+	}
+
+	if err := keyvalue.ValidateConditions(req.IfMatch, req.IfNotExists); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid conditions",
+			err,
+		)
+	}
+
 	doc := s.getDocRef(req.Ref)
+	now := time.Now()
+
+	data := req.Content.AsMap()
+	if ttl := req.Ttl.AsDuration(); ttl > 0 {
+		data[AttribExpiry] = now.Add(ttl)
+	}
+
+	var err error
+	if req.IfMatch == "" && !req.IfNotExists {
+		_, err = doc.Set(ctx, data)
+	} else {
+		// conditions are checked against the current document in a transaction, which fails if it changes before the set is committed
+		err = s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			snapshot, err := tx.Get(doc)
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+
+			exists := snapshot.Exists() && !expired(snapshot.Data(), now)
+
+			if req.IfNotExists && exists {
+				return errPreconditionFailed
+			}
+
+			if req.IfMatch != "" && (!exists || version(snapshot) != req.IfMatch) {
+				return errPreconditionFailed
+			}
+
+			return tx.Set(doc, data)
+		})
+	}
+
+	if err != nil {
+		if errors.Is(err, errPreconditionFailed) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				fmt.Sprintf("value with key %s in store %s does not satisfy the set conditions", req.Ref.Key, req.Ref.Store),
+				err,
+			)
+		}
 
-	if _, err := doc.Set(ctx, req.Content.AsMap()); err != nil {
 		if status.Code(err) == codes.PermissionDenied {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -147,8 +268,32 @@ func (s *FirestoreDocService) DeleteKey(ctx context.Context, req *v1.KvStoreDele
 
 	doc := s.getDocRef(req.Ref)
 
+	preconditions := []firestore.Precondition{}
+	if req.IfMatch != "" {
+		updateTime, err := strconv.ParseInt(req.IfMatch, 10, 64)
+		if err != nil {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch),
+				err,
+			)
+		}
+
+		preconditions = append(preconditions, firestore.LastUpdateTime(time.Unix(0, updateTime)))
+	}
+
 	// Delete document
-	if _, err := doc.Delete(ctx); err != nil {
+	if _, err := doc.Delete(ctx, preconditions...); err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.NotFound:
+			// only a delete with a last update time precondition fails when the document doesn't exist
+			return nil, newErr(
+				codes.FailedPrecondition,
+				fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch),
+				err,
+			)
+		}
+
 		if status.Code(err) == codes.PermissionDenied {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -179,7 +324,20 @@ func (s *FirestoreDocService) ScanKeys(req *v1.KvStoreScanKeysRequest, stream v1
 		)
 	}
 
-	iter := s.getCollectionRef(storeName).DocumentRefs(stream.Context())
+	if err := keyvalue.ValidateScanLimit(req.Limit); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid limit",
+			err,
+		)
+	}
+
+	// only the expiry field is selected, to exclude expired values without reading their content
+	iter := s.scanQuery(req).Documents(stream.Context())
+	defer iter.Stop()
+
+	now := time.Now()
+	keys := []string{}
 
 	for {
 		doc, err := iter.Next()
@@ -194,28 +352,208 @@ func (s *FirestoreDocService) ScanKeys(req *v1.KvStoreScanKeysRequest, stream v1
 			)
 		}
 
-		// Range queries don't appear to be supported when querying based on document ID.
-		// e.g. Where(firestore.DocumentID, "<=", req.Prefix)
-		// since prefix is a string not a DocumentRef.
-		// Instead we filter the results as they're returned
-		if !strings.HasPrefix(doc.ID, req.Prefix) {
+		// the query starts at the prefix range, and ends once a document past the range is reached
+		if cmp := keyvalue.ComparePrefix(doc.Ref.ID, req.Prefix); cmp != 0 {
+			if (cmp > 0) != req.Reverse {
+				break
+			}
 			continue
 		}
 
-		if err := stream.Send(&v1.KvStoreScanKeysResponse{
-			Key: doc.ID,
-		}); err != nil {
-			return newErr(
-				codes.Internal,
-				"failed to send response",
-				err,
-			)
+		if expiry, ok := doc.Data()[AttribExpiry].(time.Time); ok && !now.Before(expiry) {
+			continue
 		}
+
+		keys = append(keys, doc.Ref.ID)
+
+		// one key beyond the limit is read to determine if more keys remain
+		if req.Limit > 0 && len(keys) > int(req.Limit) {
+			break
+		}
+	}
+
+	keys, continuationToken := keyvalue.LimitKeys(keys, req.Limit)
+
+	if err := keyvalue.SendKeys(stream, keys, continuationToken); err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to send response",
+			err,
+		)
 	}
 
 	return nil
 }
 
+// scanQuery orders a store's documents by ID in scan order, starting from the first document the scan could return
+func (s *FirestoreDocService) scanQuery(req *v1.KvStoreScanKeysRequest) firestore.Query {
+	collection := s.getCollectionRef(req.Store.Name)
+
+	if !req.Reverse {
+		query := collection.Select(AttribExpiry).OrderBy(firestore.DocumentID, firestore.Asc)
+
+		if req.StartAfter != "" && req.StartAfter >= req.Prefix {
+			return query.StartAfter(collection.Doc(req.StartAfter))
+		}
+
+		if req.Prefix != "" {
+			return query.StartAt(collection.Doc(req.Prefix))
+		}
+
+		return query
+	}
+
+	query := collection.Select(AttribExpiry).OrderBy(firestore.DocumentID, firestore.Desc)
+
+	// scanning in reverse starts before the lower of the start_after key and the end of the prefix range
+	end := keyvalue.PrefixEnd(req.Prefix)
+	if req.StartAfter != "" && (end == "" || req.StartAfter < end) {
+		end = req.StartAfter
+	}
+
+	// the end of the prefix range may not be a valid document ID, in which case the scan skips to the range instead
+	if end != "" {
+		if doc := collection.Doc(end); doc != nil {
+			return query.StartAfter(doc)
+		}
+	}
+
+	return query
+}
+
+// GetValues retrieves multiple values in a single request, reporting a result for each key
+func (s *FirestoreDocService) GetValues(ctx context.Context, req *v1.KvStoreGetValuesRequest) (*v1.KvStoreGetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("FirestoreDocService.GetValues")
+
+	if err := keyvalue.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	docs := make([]*firestore.DocumentRef, 0, len(req.Refs))
+	for _, ref := range req.Refs {
+		docs = append(docs, s.getDocRef(ref))
+	}
+
+	// snapshots are returned in the order of the requested documents, including snapshots of documents that don't exist
+	snapshots, err := s.client.GetAll(ctx, docs)
+	if err != nil {
+		// a failed request fails the retrieval of every key, rather than the request itself
+		err = batchErr(newErr, err)
+	}
+
+	now := time.Now()
+	results := make([]*v1.KvStoreBatchResult, 0, len(req.Refs))
+	for i, ref := range req.Refs {
+		if err != nil {
+			results = append(results, keyvalue.BatchResult(ref, nil, err))
+			continue
+		}
+
+		value, valueErr := valueFromSnapshot(ref, snapshots[i], now)
+		switch {
+		case valueErr != nil:
+			results = append(results, keyvalue.BatchResult(ref, nil, newErr(codes.Internal, "error converting returned document to struct", valueErr)))
+		case value == nil:
+			results = append(results, keyvalue.BatchResult(ref, nil, newErr(codes.NotFound, fmt.Sprintf("key %s not found in store %s", ref.Key, ref.Store), nil)))
+		default:
+			results = append(results, keyvalue.BatchResult(ref, value, nil))
+		}
+	}
+
+	return &v1.KvStoreGetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// SetValues creates or overwrites multiple values using a bulk writer, reporting a result for each key
+func (s *FirestoreDocService) SetValues(ctx context.Context, req *v1.KvStoreSetValuesRequest) (*v1.KvStoreSetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("FirestoreDocService.SetValues")
+
+	refs := make([]*v1.ValueRef, 0, len(req.Values))
+	for _, value := range req.Values {
+		refs = append(refs, value.GetRef())
+	}
+
+	if err := keyvalue.ValidateValueRefs(refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	if err := keyvalue.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid ttl",
+			err,
+		)
+	}
+
+	now := time.Now()
+
+	bulkWriter := s.client.BulkWriter(ctx)
+
+	jobs := make([]*firestore.BulkWriterJob, len(req.Values))
+	errs := make([]error, len(req.Values))
+	for i, value := range req.Values {
+		if value.Content == nil {
+			errs[i] = newErr(codes.InvalidArgument, "provide non-nil value", nil)
+			continue
+		}
+
+		data := value.Content.AsMap()
+		if ttl := req.Ttl.AsDuration(); ttl > 0 {
+			data[AttribExpiry] = now.Add(ttl)
+		}
+
+		jobs[i], errs[i] = bulkWriter.Set(s.getDocRef(value.Ref), data)
+		if errs[i] != nil {
+			errs[i] = batchErr(newErr, errs[i])
+		}
+	}
+
+	results := collectBulkResults(newErr, bulkWriter, refs, jobs, errs)
+
+	return &v1.KvStoreSetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// DeleteKeys removes multiple keys using a bulk writer, reporting a result for each key
+func (s *FirestoreDocService) DeleteKeys(ctx context.Context, req *v1.KvStoreDeleteKeysRequest) (*v1.KvStoreDeleteKeysResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("FirestoreDocService.DeleteKeys")
+
+	if err := keyvalue.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid keys",
+			err,
+		)
+	}
+
+	bulkWriter := s.client.BulkWriter(ctx)
+
+	jobs := make([]*firestore.BulkWriterJob, len(req.Refs))
+	errs := make([]error, len(req.Refs))
+	for i, ref := range req.Refs {
+		jobs[i], errs[i] = bulkWriter.Delete(s.getDocRef(ref))
+		if errs[i] != nil {
+			errs[i] = batchErr(newErr, errs[i])
+		}
+	}
+
+	results := collectBulkResults(newErr, bulkWriter, req.Refs, jobs, errs)
+
+	return &v1.KvStoreDeleteKeysResponse{
+		Results: results,
+	}, nil
+}
+
 func New() (v1.KvStoreServer, error) {
 	ctx := context.Background()
 
@@ -247,3 +585,23 @@ func (s *FirestoreDocService) getDocRef(ref *v1.ValueRef) *firestore.DocumentRef
 func (s *FirestoreDocService) getCollectionRef(store string) *firestore.CollectionRef {
 	return s.client.Collection(store)
 }
+
+// collectBulkResults ends a bulk writer and reports the result of each of its jobs, keys without a job report the error that prevented it
+func collectBulkResults(newErr grpc_errors.ScopedErrorFactory, bulkWriter *firestore.BulkWriter, refs []*v1.ValueRef, jobs []*firestore.BulkWriterJob, errs []error) []*v1.KvStoreBatchResult {
+	// flush all enqueued writes, individual writes are retried by the bulk writer until they succeed or fail permanently
+	bulkWriter.End()
+
+	results := make([]*v1.KvStoreBatchResult, 0, len(refs))
+	for i, ref := range refs {
+		err := errs[i]
+		if err == nil && jobs[i] != nil {
+			if _, jobErr := jobs[i].Results(); jobErr != nil {
+				err = batchErr(newErr, jobErr)
+			}
+		}
+
+		results = append(results, keyvalue.BatchResult(ref, nil, err))
+	}
+
+	return results
+}
@@ -18,7 +18,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
+	"time"
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
@@ -38,12 +40,19 @@ import (
 	"google.golang.org/api/option"
 
 	ifaces_pubsub "github.com/nitrictech/nitric/cloud/gcp/ifaces/pubsub"
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
 )
 
+// maxAckDeadline is the longest Pub/Sub allows a pulled message to remain unacknowledged before it's redelivered
+const maxAckDeadline = 10 * time.Minute
+
+// maxPullWait is the longest a pull can wait for messages, matching the limit of SQS long polling so waits behave the same across providers
+const maxPullWait = 20 * time.Second
+
 type PubsubQueueService struct {
 	queuespb.UnimplementedQueuesServer
 	// queue.UnimplementedQueuePlugin
@@ -158,7 +167,17 @@ func (s *PubsubQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnq
 
 	for _, task := range req.Messages {
 		t := task
-		if taskBytes, err := proto.Marshal(t); err == nil {
+
+		if t.Delay.AsDuration() > 0 {
+			// Pub/Sub delivers published messages immediately
+			failedTasks = append(failedTasks, &queuespb.FailedEnqueueMessage{
+				Message: t,
+				Details: "delayed messages are not supported by Pub/Sub queues",
+			})
+			continue
+		}
+
+		if taskBytes, err := proto.Marshal(&queuespb.QueueMessage{Content: t.Content, ContentType: t.ContentType}); err == nil {
 			msg := ifaces_pubsub.AdaptPubsubMessage(&pubsub.Message{
 				Data:       taskBytes,
 				Attributes: attributes,
@@ -193,6 +212,22 @@ func (s *PubsubQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnq
 func (s *PubsubQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeueRequest) (*queuespb.QueueDequeueResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.Dequeue")
 
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxAckDeadline); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	if err := queue.ValidateWait(req.Wait, maxPullWait); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid wait time",
+			err,
+		)
+	}
+
 	// Find the generic pull subscription for the provided topic (queue)
 	queueSubscription, err := s.getQueueSubscription(ctx, req.QueueName)
 	if err != nil {
@@ -221,8 +256,24 @@ func (s *PubsubQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDeq
 		Subscription: queueSubscription.String(),
 		MaxMessages:  req.GetDepth(),
 	}
-	res, err := client.Pull(ctx, &pubsubRequest)
+
+	// when waiting, the pull blocks until messages are available or its deadline is reached
+	pullCtx := ctx
+	if req.Wait.AsDuration() > 0 {
+		var cancel context.CancelFunc
+		pullCtx, cancel = context.WithTimeout(ctx, req.Wait.AsDuration())
+		defer cancel()
+	}
+
+	res, err := client.Pull(pullCtx, &pubsubRequest)
 	if err != nil {
+		// reaching the wait deadline means no messages became available in time
+		if errors.Is(pullCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return &queuespb.QueueDequeueResponse{
+				Messages: []*queuespb.DequeuedMessage{},
+			}, nil
+		}
+
 		errStatus, _ := status.FromError(err)
 		if errStatus.Code() == grpccodes.PermissionDenied {
 			return nil, newErr(
@@ -245,6 +296,18 @@ func (s *PubsubQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDeq
 		}, nil
 	}
 
+	// pulled messages are leased for the subscription's ack deadline, which is modified if a lease duration was requested
+	if req.LeaseDuration != nil {
+		ackIds := make([]string, 0, len(res.ReceivedMessages))
+		for _, m := range res.ReceivedMessages {
+			ackIds = append(ackIds, m.AckId)
+		}
+
+		if err := setAckDeadline(ctx, client, queueSubscription.String(), ackIds, req.LeaseDuration.AsDuration()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Convert the PubSub messages into Nitric tasks
 	var tasks []*queuespb.DequeuedMessage
 	for _, m := range res.ReceivedMessages {
@@ -262,6 +325,8 @@ func (s *PubsubQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDeq
 		tasks = append(tasks, &queuespb.DequeuedMessage{
 			Message: &queueMessage,
 			LeaseId: m.AckId,
+			// delivery attempts are only tracked by Pub/Sub for subscriptions with a dead letter policy, otherwise this is 0
+			ReceiveCount: m.DeliveryAttempt,
 		})
 	}
 
@@ -321,6 +386,111 @@ func (s *PubsubQueueService) Complete(ctx context.Context, req *queuespb.QueueCo
 	return &queuespb.QueueCompleteResponse{}, nil
 }
 
+// ExtendLease on a previously dequeued message, by modifying its ack deadline
+func (s *PubsubQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.ExtendLease")
+
+	if req.LeaseDuration == nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"lease duration is required",
+			nil,
+		)
+	}
+
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxAckDeadline); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid lease duration",
+			err,
+		)
+	}
+
+	if err := s.modifyAckDeadline(ctx, req.QueueName, req.LeaseId, req.LeaseDuration.AsDuration()); err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: req.LeaseId,
+	}, nil
+}
+
+// Release a previously dequeued message without completing it, by setting its ack deadline to the requested delay
+func (s *PubsubQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.Release")
+
+	if err := queue.ValidateDelay(req.Delay, maxAckDeadline); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid delay",
+			err,
+		)
+	}
+
+	// an ack deadline of zero is a negative acknowledgement, making the message available for redelivery immediately
+	if err := s.modifyAckDeadline(ctx, req.QueueName, req.LeaseId, req.Delay.AsDuration()); err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// modifyAckDeadline sets how long until a dequeued message is redelivered, starting from now
+func (s *PubsubQueueService) modifyAckDeadline(ctx context.Context, queueName string, leaseId string, deadline time.Duration) error {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.modifyAckDeadline")
+
+	// Find the generic pull subscription for the provided topic (queue)
+	queueSubscription, err := s.getQueueSubscription(ctx, queueName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"could not find queue subscription",
+			err,
+		)
+	}
+
+	client, err := s.newSubscriberClient(ctx)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to create subscriber client",
+			err,
+		)
+	}
+	defer client.Close()
+
+	return setAckDeadline(ctx, client, queueSubscription.String(), []string{leaseId}, deadline)
+}
+
+// setAckDeadline sets the ack deadline of pulled messages using an existing subscriber client
+func setAckDeadline(ctx context.Context, client ifaces_pubsub.SubscriberClient, subscription string, ackIds []string, deadline time.Duration) error {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.setAckDeadline")
+
+	err := client.ModifyAckDeadline(ctx, &pubsubpb.ModifyAckDeadlineRequest{
+		Subscription: subscription,
+		AckIds:       ackIds,
+		// rounded up so the deadline is never shorter than requested
+		AckDeadlineSeconds: int32(math.Ceil(deadline.Seconds())),
+	})
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		if errStatus.Code() == grpccodes.PermissionDenied {
+			return newErr(
+				codes.PermissionDenied,
+				"permission denied, have you requested access to the queue?",
+				err)
+		}
+
+		return newErr(
+			codes.Internal,
+			"failed to modify message ack deadline",
+			err,
+		)
+	}
+
+	return nil
+}
+
 // adaptNewClient - Adapts the pubsubbase.NewSubscriberClient func to one that implements the SubscriberClient
 // interface. This is used to enable substitution of the base pubsub client, primarily for mocking support.
 func adaptNewClient(f func(context.Context, ...option.ClientOption) (*pubsubbase.SubscriberClient, error)) func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
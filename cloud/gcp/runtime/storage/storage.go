@@ -32,6 +32,7 @@ import (
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	content "github.com/nitrictech/nitric/cloud/common/runtime/storage"
 	ifaces_gcloud_storage "github.com/nitrictech/nitric/cloud/gcp/ifaces/gcloud_storage"
@@ -153,8 +154,16 @@ func (s *StorageStorageService) Write(ctx context.Context, req *storagePb.Storag
 		)
 	}
 
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = content.DetectContentType(req.Key, req.Body)
+	}
+
 	writer := bucketHandle.Object(req.Key).NewWriter(ctx)
-	writer.ObjectAttrs().ContentType = content.DetectContentType(req.Key, req.Body)
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = contentType
+	attrs.CacheControl = req.CacheControl
+	attrs.Metadata = req.Metadata
 
 	if _, err := writer.Write(req.Body); err != nil {
 		if isPermissionDenied(err) {
@@ -191,6 +200,141 @@ func (s *StorageStorageService) Write(ctx context.Context, req *storagePb.Storag
 	return &storagePb.StorageWriteResponse{}, nil
 }
 
+/**
+ * Streams a previously stored object, or a byte range of an object, from a Google Cloud Storage Bucket
+ */
+func (s *StorageStorageService) ReadStream(req *storagePb.StorageReadStreamRequest, stream storagePb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.ReadStream")
+
+	if req.Offset < 0 || req.Length < 0 {
+		return newErr(
+			codes.InvalidArgument,
+			"offset and length cannot be negative",
+			nil,
+		)
+	}
+
+	bucketHandle, err := s.getBucketByName(req.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	// a negative length reads to the end of the object
+	length := req.Length
+	if length == 0 {
+		length = -1
+	}
+
+	reader, err := bucketHandle.Object(req.Key).NewRangeReader(stream.Context(), req.Offset, length)
+	if err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to read file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"unable to get reader for object",
+			err,
+		)
+	}
+	defer reader.Close()
+
+	if err := content.SendChunks(reader, stream); err != nil {
+		return newErr(
+			codes.Internal,
+			"error streaming object",
+			err,
+		)
+	}
+
+	return nil
+}
+
+/**
+ * Stores a new Item in a Google Cloud Storage Bucket from a stream of chunks
+ */
+func (s *StorageStorageService) WriteStream(stream storagePb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.WriteStream")
+
+	metadata, body, err := content.NewWriteStreamReader(stream)
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid write stream",
+			err,
+		)
+	}
+
+	bucketHandle, err := s.getBucketByName(metadata.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	// cancelling the writer context aborts the upload, so failed streams don't leave a partial object
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var reader io.Reader = body
+
+	contentType := metadata.ContentType
+	if contentType == "" {
+		contentType, reader = content.DetectStreamContentType(metadata.Key, body)
+	}
+
+	// the writer uploads the object with a resumable upload, sending it in chunks as they're buffered
+	writer := bucketHandle.Object(metadata.Key).NewWriter(ctx)
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = contentType
+	attrs.CacheControl = metadata.CacheControl
+	attrs.Metadata = metadata.Metadata
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write to file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"unable to write object",
+			err,
+		)
+	}
+
+	if err := writer.Close(); err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write to file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"error closing object write",
+			err,
+		)
+	}
+
+	return stream.SendAndClose(&storagePb.StorageWriteResponse{})
+}
+
 /**
  * Delete an Item in a Google Cloud Storage Bucket
  */
@@ -229,6 +373,87 @@ func (s *StorageStorageService) Delete(ctx context.Context, req *storagePb.Stora
 	return &storagePb.StorageDeleteResponse{}, nil
 }
 
+/**
+ * Copies an object to another key, in the same or another Google Cloud Storage Bucket
+ */
+func (s *StorageStorageService) Copy(ctx context.Context, req *storagePb.StorageCopyRequest) (*storagePb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.Copy")
+
+	sourceBucket, err := s.getBucketByName(req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate source bucket",
+			err,
+		)
+	}
+
+	destinationBucket, err := s.getBucketByName(req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate destination bucket",
+			err,
+		)
+	}
+
+	// the copier rewrites the object server side, making as many requests as large objects require
+	copier := destinationBucket.Object(req.DestinationKey).CopierFrom(sourceBucket.Object(req.SourceKey))
+	if _, err := copier.Run(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, newErr(
+				codes.NotFound,
+				fmt.Sprintf("object %s does not exist", req.SourceKey),
+				err,
+			)
+		}
+
+		if isPermissionDenied(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to copy file, have you requested access to both buckets?",
+				err,
+			)
+		}
+
+		return nil, newErr(
+			codes.Internal,
+			"unable to copy object",
+			err,
+		)
+	}
+
+	return &storagePb.StorageCopyResponse{}, nil
+}
+
+/**
+ * Moves an object to another key, in the same or another Google Cloud Storage Bucket, by copying it and deleting the original
+ */
+func (s *StorageStorageService) Move(ctx context.Context, req *storagePb.StorageMoveRequest) (*storagePb.StorageMoveResponse, error) {
+	if _, err := s.Copy(ctx, &storagePb.StorageCopyRequest{
+		SourceBucketName:      req.SourceBucketName,
+		SourceKey:             req.SourceKey,
+		DestinationBucketName: req.DestinationBucketName,
+		DestinationKey:        req.DestinationKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	// moving an object onto itself leaves it in place
+	if req.SourceBucketName == req.DestinationBucketName && req.SourceKey == req.DestinationKey {
+		return &storagePb.StorageMoveResponse{}, nil
+	}
+
+	if _, err := s.Delete(ctx, &storagePb.StorageDeleteRequest{
+		BucketName: req.SourceBucketName,
+		Key:        req.SourceKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &storagePb.StorageMoveResponse{}, nil
+}
+
 func (s *StorageStorageService) PreSignUrl(ctx context.Context, req *storagePb.StoragePreSignUrlRequest) (*storagePb.StoragePreSignUrlResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.PreSignedUrl")
 
@@ -269,6 +494,14 @@ func (s *StorageStorageService) PreSignUrl(ctx context.Context, req *storagePb.S
 func (s *StorageStorageService) ListBlobs(ctx context.Context, req *storagePb.StorageListBlobsRequest) (*storagePb.StorageListBlobsResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.ListFiles")
 
+	if req.PageSize < 0 {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"page size cannot be negative",
+			nil,
+		)
+	}
+
 	bucketHandle, err := s.getBucketByName(req.BucketName)
 	if err != nil {
 		return nil, newErr(
@@ -281,27 +514,52 @@ func (s *StorageStorageService) ListBlobs(ctx context.Context, req *storagePb.St
 	iter := bucketHandle.Objects(ctx, &storage.Query{
 		Projection: storage.ProjectionNoACL,
 		Prefix:     req.Prefix,
+		Delimiter:  req.Delimiter,
 	})
 
-	fis := make([]*storagePb.Blob, 0)
-	for {
-		obj, err := iter.Next()
+	resp := &storagePb.StorageListBlobsResponse{
+		Blobs:    []*storagePb.Blob{},
+		Prefixes: []string{},
+	}
 
-		if errors.Is(err, iterator.Done) {
-			break
-		}
+	objs := []*storage.ObjectAttrs{}
+
+	if req.PageSize > 0 {
+		// the pager fetches exactly one page of results, so the token can resume from the next object
+		resp.NextPageToken, err = iterator.NewPager(iter, int(req.PageSize), req.PageToken).NextPage(&objs)
 		if err != nil {
 			return nil, newErr(codes.Internal, "error occurred iterating objects", err)
 		}
+	} else {
+		if req.PageToken != "" {
+			iter.PageInfo().Token = req.PageToken
+		}
+
+		for {
+			obj, err := iter.Next()
 
-		fis = append(fis, &storagePb.Blob{
-			Key: obj.Name,
-		})
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, newErr(codes.Internal, "error occurred iterating objects", err)
+			}
+
+			objs = append(objs, obj)
+		}
 	}
 
-	return &storagePb.StorageListBlobsResponse{
-		Blobs: fis,
-	}, nil
+	for _, obj := range objs {
+		// objects representing common prefixes only have their prefix set
+		if obj.Prefix != "" {
+			resp.Prefixes = append(resp.Prefixes, obj.Prefix)
+			continue
+		}
+
+		resp.Blobs = append(resp.Blobs, blobFromAttrs(obj))
+	}
+
+	return resp, nil
 }
 
 func (s *StorageStorageService) Exists(ctx context.Context, req *storagePb.StorageExistsRequest) (*storagePb.StorageExistsResponse, error) {
@@ -331,6 +589,64 @@ func (s *StorageStorageService) Exists(ctx context.Context, req *storagePb.Stora
 	}, nil
 }
 
+/**
+ * Retrieves the attributes of an object in a Google Cloud Storage Bucket
+ */
+func (s *StorageStorageService) Stat(ctx context.Context, req *storagePb.StorageStatRequest) (*storagePb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.Stat")
+
+	bucketHandle, err := s.getBucketByName(req.BucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	attrs, err := bucketHandle.Object(req.Key).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, newErr(
+				codes.NotFound,
+				fmt.Sprintf("object %s does not exist", req.Key),
+				err,
+			)
+		}
+
+		if isPermissionDenied(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to read file attributes, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return nil, newErr(codes.Internal, "error calling object.Attrs", err)
+	}
+
+	return &storagePb.StorageStatResponse{
+		Blob: blobFromAttrs(attrs),
+	}, nil
+}
+
+func blobFromAttrs(attrs *storage.ObjectAttrs) *storagePb.Blob {
+	blob := &storagePb.Blob{
+		Key:          attrs.Name,
+		Size:         attrs.Size,
+		Etag:         attrs.Etag,
+		ContentType:  attrs.ContentType,
+		CacheControl: attrs.CacheControl,
+		Metadata:     attrs.Metadata,
+	}
+
+	if !attrs.Updated.IsZero() {
+		blob.LastModified = timestamppb.New(attrs.Updated)
+	}
+
+	return blob
+}
+
 /**
  * Creates a new Storage Plugin for use in GCP
  */
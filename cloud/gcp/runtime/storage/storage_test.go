@@ -28,6 +28,9 @@ import (
 	. "github.com/onsi/gomega"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	storage_mock "github.com/nitrictech/nitric/cloud/gcp/mocks/gcp_storage"
@@ -35,6 +38,48 @@ import (
 	storagePb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// mockReadStream collects the chunks sent by ReadStream
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagePb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+// mockWriteStream sends the given messages to WriteStream
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagePb.StorageWriteStreamRequest
+	closed   bool
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagePb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(*storagePb.StorageWriteResponse) error {
+	m.closed = true
+	return nil
+}
+
 var _ = Describe("Storage", func() {
 	os.Setenv("NITRIC_STACK_ID", "test-stack")
 
@@ -825,4 +870,318 @@ var _ = Describe("Storage", func() {
 			})
 		})
 	})
+
+	Context("Copy", func() {
+		When("The source and destination buckets exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockSourceBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockDestinationBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockSourceObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockDestinationObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("should copy the object to the destination bucket", func() {
+				By("the buckets existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "source-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "source-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "destination-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "destination-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("source-bucket-1234").Return(mockSourceBucket)
+				mockStorageClient.EXPECT().Bucket("destination-bucket-1234").Return(mockDestinationBucket)
+
+				By("the object references being valid")
+				mockSourceBucket.EXPECT().Object("test-key").Return(mockSourceObject)
+				mockDestinationBucket.EXPECT().Object("copied-key").Return(mockDestinationObject)
+
+				By("copying the object")
+				mockDestinationObject.EXPECT().CopierFrom(mockSourceObject).Return(mockCopier)
+				mockCopier.EXPECT().Run(gomock.Any()).Return(&storage.ObjectAttrs{}, nil)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagePb.StorageCopyRequest{
+					SourceBucketName:      "source-bucket",
+					SourceKey:             "test-key",
+					DestinationBucketName: "destination-bucket",
+					DestinationKey:        "copied-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The source object doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockSourceObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockDestinationObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("should return a not found error", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the object references being valid")
+				mockBucket.EXPECT().Object("missing-key").Return(mockSourceObject)
+				mockBucket.EXPECT().Object("copied-key").Return(mockDestinationObject)
+
+				By("the source object not existing")
+				mockDestinationObject.EXPECT().CopierFrom(mockSourceObject).Return(mockCopier)
+				mockCopier.EXPECT().Run(gomock.Any()).Return(nil, storage.ErrObjectNotExist)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagePb.StorageCopyRequest{
+					SourceBucketName:      "test-bucket",
+					SourceKey:             "missing-key",
+					DestinationBucketName: "test-bucket",
+					DestinationKey:        "copied-key",
+				})
+
+				By("Returning a not found error")
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Stat", func() {
+		When("The item exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("should return the object attributes", func() {
+				updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the object reference being valid")
+				mockBucket.EXPECT().Object("test-key").Return(mockObject)
+
+				By("returning object attributes")
+				mockObject.EXPECT().Attrs(gomock.Any()).Times(1).Return(&storage.ObjectAttrs{
+					Name:         "test-key",
+					Size:         12,
+					Etag:         "abc123",
+					Updated:      updated,
+					ContentType:  "text/plain",
+					CacheControl: "max-age=60",
+					Metadata:     map[string]string{"owner": "test"},
+				}, nil)
+
+				response, err := storagePlugin.Stat(context.TODO(), &storagePb.StorageStatRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the object attributes")
+				Expect(response.Blob.Key).To(Equal("test-key"))
+				Expect(response.Blob.Size).To(Equal(int64(12)))
+				Expect(response.Blob.Etag).To(Equal("abc123"))
+				Expect(response.Blob.LastModified.AsTime()).To(Equal(updated))
+				Expect(response.Blob.ContentType).To(Equal("text/plain"))
+				Expect(response.Blob.CacheControl).To(Equal("max-age=60"))
+				Expect(response.Blob.Metadata).To(Equal(map[string]string{"owner": "test"}))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The item doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("should return a not found error", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the object reference being valid")
+				mockBucket.EXPECT().Object("test-key").Return(mockObject)
+
+				By("the object not existing")
+				mockObject.EXPECT().Attrs(gomock.Any()).Times(1).Return(nil, storage.ErrObjectNotExist)
+
+				response, err := storagePlugin.Stat(context.TODO(), &storagePb.StorageStatRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+				})
+
+				By("Returning a not found error")
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+				By("Returning a nil response")
+				Expect(response).Should(BeNil())
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("ReadStream", func() {
+		When("Reading the rest of an item from an offset", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockReader := storage_mock.NewMockReader(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should stream the item from the offset", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("reading to the end of the object from the offset")
+				mockBucket.EXPECT().Object("test-key").Return(mockObject)
+				mockObject.EXPECT().NewRangeReader(gomock.Any(), int64(2), int64(-1)).Return(mockReader, nil)
+
+				gomock.InOrder(
+					mockReader.EXPECT().Read(gomock.Any()).DoAndReturn(func(p []byte) (int, error) {
+						return copy(p, "st"), nil
+					}),
+					mockReader.EXPECT().Read(gomock.Any()).Return(0, io.EOF),
+				)
+				mockReader.EXPECT().Close().Times(1)
+
+				stream := &mockReadStream{}
+				err := storagePlugin.ReadStream(&storagePb.StorageReadStreamRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+					Offset:     2,
+				}, stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Sending the content from the offset")
+				Expect(stream.chunks).To(Equal([][]byte{[]byte("st")}))
+			})
+		})
+	})
+
+	Context("WriteStream", func() {
+		When("Writing to a bucket that exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockWriter := storage_mock.NewMockWriter(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should store the streamed item", func() {
+				By("The bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "my-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				mockBucket.EXPECT().Object("test-file").Return(mockObject)
+				mockObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+
+				By("The chunks being written")
+				mockWriter.EXPECT().ObjectAttrs().Times(1).Return(&storage.ObjectAttrs{})
+				mockWriter.EXPECT().Write([]byte("Test")).Return(4, nil)
+				mockWriter.EXPECT().Close().Times(1)
+
+				stream := &mockWriteStream{requests: []*storagePb.StorageWriteStreamRequest{
+					{Content: &storagePb.StorageWriteStreamRequest_Metadata{Metadata: &storagePb.StorageWriteStreamMetadata{
+						BucketName: "my-bucket",
+						Key:        "test-file",
+					}}},
+					{Content: &storagePb.StorageWriteStreamRequest_Chunk{Chunk: []byte("Test")}},
+				}}
+
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.closed).To(BeTrue())
+			})
+		})
+	})
 })
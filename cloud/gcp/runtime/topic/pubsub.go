@@ -42,11 +42,15 @@ import (
 	ifaces_pubsub "github.com/nitrictech/nitric/cloud/gcp/ifaces/pubsub"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/resource"
+	"github.com/nitrictech/nitric/core/pkg/decorators/topic"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 )
 
+// maxAttributes is the most attributes a message can be published with, Pub/Sub allows 100 and two are used for the source topic and trace propagation
+const maxAttributes = 98
+
 type PubsubEventService struct {
 	resource.GcpResourceResolver
 	client      ifaces_pubsub.PubsubClient
@@ -110,6 +114,10 @@ func (s *PubsubEventService) publish(ctx context.Context, topic string, pubsubMs
 	}
 
 	_, err = pubsubTopic.Publish(ctx, msg).Get(ctx)
+	if err != nil && pubsubMsg.OrderingKey != "" {
+		// publishing is paused for an ordering key after a failure, resume it so later messages can be published
+		pubsubTopic.ResumePublish(pubsubMsg.OrderingKey)
+	}
 
 	return err
 }
@@ -163,6 +171,25 @@ func (s *PubsubEventService) publishDelayed(ctx context.Context, topic string, d
 	return err
 }
 
+// newPubsubMessage creates a Pub/Sub message with the published attributes, the source topic and the trace context
+func newPubsubMessage(ctx context.Context, topicName string, data []byte, publishedAttributes map[string]string, orderingKey string) *pubsub.Message {
+	attributes := propagation.MapCarrier{}
+	for k, v := range publishedAttributes {
+		attributes[k] = v
+	}
+
+	// allows ctx to include the name of the source topic.
+	attributes["x-nitric-topic"] = topicName
+
+	propagator.CloudTraceFormatPropagator{}.Inject(ctx, attributes)
+
+	return &pubsub.Message{
+		Attributes:  attributes,
+		Data:        data,
+		OrderingKey: orderingKey,
+	}
+}
+
 func (s *PubsubEventService) Publish(ctx context.Context, req *topicpb.TopicPublishRequest) (*topicpb.TopicPublishResponse, error) {
 	delay := req.Delay.AsDuration()
 	newErr := grpc_errors.ErrorsWithScope("PubsubEventService.Publish")
@@ -176,18 +203,16 @@ func (s *PubsubEventService) Publish(ctx context.Context, req *topicpb.TopicPubl
 		)
 	}
 
-	// allows ctx to include the name of the source topic.
-	attributes := propagation.MapCarrier{
-		"x-nitric-topic": req.TopicName,
+	if err := topic.ValidateAttributes(req.Attributes, maxAttributes); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid attributes", err)
 	}
 
-	propagator.CloudTraceFormatPropagator{}.Inject(ctx, attributes)
-
-	pubsubMsg := &pubsub.Message{
-		Attributes: attributes,
-		Data:       messageBytes,
+	if err := topic.ValidateOrderingKey(req.OrderingKey, req.Delay); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid ordering key", err)
 	}
 
+	pubsubMsg := newPubsubMessage(ctx, req.TopicName, messageBytes, req.Attributes, req.OrderingKey)
+
 	if delay > 0 {
 		err = s.publishDelayed(ctx, req.TopicName, delay, pubsubMsg)
 	} else {
@@ -213,6 +238,68 @@ func (s *PubsubEventService) Publish(ctx context.Context, req *topicpb.TopicPubl
 	return &topicpb.TopicPublishResponse{}, nil
 }
 
+// PublishBatch publishes multiple messages to a given topic, the Pub/Sub client batches them into as few requests as it can
+func (s *PubsubEventService) PublishBatch(ctx context.Context, req *topicpb.TopicPublishBatchRequest) (*topicpb.TopicPublishBatchResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("PubsubEventService.PublishBatch")
+
+	pubsubTopic, err := s.getPubsubTopicFromName(req.TopicName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "unable to find topic", err)
+	}
+
+	type pendingMessage struct {
+		message *topicpb.TopicBatchMessage
+		result  ifaces_pubsub.PublishResult
+	}
+
+	failedMessages := []*topicpb.FailedPublishMessage{}
+	pending := []pendingMessage{}
+
+	for _, message := range req.Messages {
+		if err := topic.ValidateAttributes(message.Attributes, maxAttributes); err != nil {
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: message,
+				Details: err.Error(),
+			})
+			continue
+		}
+
+		messageBytes, err := proto.Marshal(message.Message)
+		if err != nil {
+			return nil, newErr(
+				codes.Unknown,
+				fmt.Sprintf("unable to serialize message. %s", help.BugInNitricHelpText()),
+				err,
+			)
+		}
+
+		pubsubMsg := newPubsubMessage(ctx, req.TopicName, messageBytes, message.Attributes, message.OrderingKey)
+
+		pending = append(pending, pendingMessage{
+			message: message,
+			result:  pubsubTopic.Publish(ctx, ifaces_pubsub.AdaptPubsubMessage(pubsubMsg)),
+		})
+	}
+
+	// wait for every message to be published before reporting the failures
+	for _, p := range pending {
+		if _, err := p.result.Get(ctx); err != nil {
+			if p.message.OrderingKey != "" {
+				pubsubTopic.ResumePublish(p.message.OrderingKey)
+			}
+
+			failedMessages = append(failedMessages, &topicpb.FailedPublishMessage{
+				Message: p.message,
+				Details: err.Error(),
+			})
+		}
+	}
+
+	return &topicpb.TopicPublishBatchResponse{
+		FailedMessages: failedMessages,
+	}, nil
+}
+
 func New(provider resource.GcpResourceResolver) (topicpb.TopicsServer, error) {
 	ctx := context.Background()
 
@@ -119,6 +119,119 @@ var _ = Describe("Pubsub Plugin", func() {
 				Expect(err.Error()).Should(ContainSubstring("PermissionDenied desc = PubsubEventService.Publish permission denied, have you requested access to this topic?"))
 			})
 		})
+		When("Publishing with an ordering key fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			pubsubClient := mock_pubsub.NewMockPubsubClient(ctrl)
+			mockTopic := mock_pubsub.NewMockTopic(ctrl)
+			mockIterator := mock_pubsub.NewMockTopicIterator(ctrl)
+			mockPublishResult := mock_pubsub.NewMockPublishResult(ctrl)
+			pubsubPlugin, _ := pubsub_service.NewWithClient(nil, pubsubClient, nil)
+
+			It("should resume publishing for the ordering key", func() {
+				By("the topic existing")
+				pubsubClient.EXPECT().Topics(gomock.Any()).Return(mockIterator)
+				gomock.InOrder(
+					mockIterator.EXPECT().Next().Return(mockTopic, nil),
+					mockIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+
+				mockTopic.EXPECT().Labels(gomock.Any()).Return(map[string]string{
+					"x-nitric-test-stack-name": "Test",
+					"x-nitric-test-stack-type": "topic",
+				}, nil)
+
+				By("the publish failing")
+				mockPublishResult.EXPECT().Get(gomock.Any()).Return("", status.Error(codes.Unavailable, "unavailable")).Times(1)
+				mockTopic.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(mockPublishResult).Times(1)
+
+				By("resuming publishing for the ordering key")
+				mockTopic.EXPECT().ResumePublish("customer-1").Times(1)
+
+				_, err := pubsubPlugin.Publish(context.TODO(), &topicpb.TopicPublishRequest{
+					TopicName:   "Test",
+					Message:     message,
+					Attributes:  map[string]string{"region": "australia-southeast1"},
+					OrderingKey: "customer-1",
+				})
+
+				Expect(err).Should(HaveOccurred())
+				ctrl.Finish()
+			})
+		})
+
+		When("Publishing with a reserved attribute", func() {
+			pubsubPlugin, _ := pubsub_service.NewWithClient(nil, nil, nil)
+
+			It("should return an invalid argument error", func() {
+				_, err := pubsubPlugin.Publish(context.TODO(), &topicpb.TopicPublishRequest{
+					TopicName:  "Test",
+					Message:    message,
+					Attributes: map[string]string{"x-nitric-topic": "Other"},
+				})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	When("Publishing a batch of messages", func() {
+		payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+		message := &topicpb.TopicMessage{
+			Content: &topicpb.TopicMessage_StructPayload{
+				StructPayload: payload,
+			},
+		}
+
+		When("Some of the messages fail to publish", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			pubsubClient := mock_pubsub.NewMockPubsubClient(ctrl)
+			mockTopic := mock_pubsub.NewMockTopic(ctrl)
+			mockIterator := mock_pubsub.NewMockTopicIterator(ctrl)
+			successResult := mock_pubsub.NewMockPublishResult(ctrl)
+			failedResult := mock_pubsub.NewMockPublishResult(ctrl)
+			pubsubPlugin, _ := pubsub_service.NewWithClient(nil, pubsubClient, nil)
+
+			published := &topicpb.TopicBatchMessage{Message: message}
+			unpublished := &topicpb.TopicBatchMessage{Message: message, OrderingKey: "customer-1"}
+			invalid := &topicpb.TopicBatchMessage{Message: message, Attributes: map[string]string{"x-nitric-topic": "Other"}}
+
+			It("should return the failed messages", func() {
+				By("the topic existing")
+				pubsubClient.EXPECT().Topics(gomock.Any()).Return(mockIterator)
+				gomock.InOrder(
+					mockIterator.EXPECT().Next().Return(mockTopic, nil),
+					mockIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+
+				mockTopic.EXPECT().Labels(gomock.Any()).Return(map[string]string{
+					"x-nitric-test-stack-name": "Test",
+					"x-nitric-test-stack-type": "topic",
+				}, nil)
+
+				By("publishing the valid messages")
+				gomock.InOrder(
+					mockTopic.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(successResult),
+					mockTopic.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(failedResult),
+				)
+				successResult.EXPECT().Get(gomock.Any()).Return("mock-server", nil)
+				failedResult.EXPECT().Get(gomock.Any()).Return("", status.Error(codes.Unavailable, "unavailable"))
+
+				By("resuming publishing for the failed ordering key")
+				mockTopic.EXPECT().ResumePublish("customer-1").Times(1)
+
+				resp, err := pubsubPlugin.PublishBatch(context.TODO(), &topicpb.TopicPublishBatchRequest{
+					TopicName: "Test",
+					Messages:  []*topicpb.TopicBatchMessage{published, invalid, unpublished},
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(HaveLen(2))
+				Expect(resp.FailedMessages[0].Message).To(Equal(invalid))
+				Expect(resp.FailedMessages[1].Message).To(Equal(unpublished))
+				ctrl.Finish()
+			})
+		})
 	})
 
 	When("Publishing Delayed Messages", func() {
@@ -5,6 +5,7 @@
 package mock_schedules
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,18 +36,18 @@ func (m *MockScheduleRequestHandler) EXPECT() *MockScheduleRequestHandlerMockRec
 }
 
 // HandleRequest mocks base method.
-func (m *MockScheduleRequestHandler) HandleRequest(arg0 *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
+func (m *MockScheduleRequestHandler) HandleRequest(arg0 context.Context, arg1 *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
 	ret0, _ := ret[0].(*schedulespb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockScheduleRequestHandlerMockRecorder) HandleRequest(arg0 interface{}) *gomock.Call {
+func (mr *MockScheduleRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockScheduleRequestHandler)(nil).HandleRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockScheduleRequestHandler)(nil).HandleRequest), arg0, arg1)
 }
 
 // Schedule mocks base method.
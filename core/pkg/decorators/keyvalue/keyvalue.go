@@ -16,6 +16,12 @@ package keyvalue
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	v1 "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 )
@@ -45,6 +51,56 @@ func ValidateValueRef(key *v1.ValueRef) error {
 	return nil
 }
 
+// ValidateValueRefs - validates the keys of a batch operation, which must each be valid and unique within the batch
+func ValidateValueRefs(refs []*v1.ValueRef) error {
+	type storeKey struct{ store, key string }
+
+	seen := make(map[storeKey]bool, len(refs))
+	for _, ref := range refs {
+		if err := ValidateValueRef(ref); err != nil {
+			return err
+		}
+		if seen[storeKey{ref.Store, ref.Key}] {
+			return fmt.Errorf("provide unique keys, key %s in store %s is repeated", ref.Key, ref.Store)
+		}
+		seen[storeKey{ref.Store, ref.Key}] = true
+	}
+	return nil
+}
+
+// BatchResult - creates the result of a batch operation for a single key from the error of the operation, nil if it succeeded
+func BatchResult(ref *v1.ValueRef, value *v1.Value, err error) *v1.KvStoreBatchResult {
+	st := status.Convert(err)
+	return &v1.KvStoreBatchResult{
+		Ref:     ref,
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+		Value:   value,
+	}
+}
+
+// ValidateConditions - validates the optional conditions of a set, which can't require a value to both exist and not exist
+func ValidateConditions(ifMatch string, ifNotExists bool) error {
+	if ifMatch != "" && ifNotExists {
+		return fmt.Errorf("provide either if_match or if_not_exists, not both")
+	}
+	return nil
+}
+
+// ValidateTtl - validates an optional value time to live, a nil or zero ttl means the value never expires
+func ValidateTtl(ttl *durationpb.Duration) error {
+	if ttl == nil {
+		return nil
+	}
+	if err := ttl.CheckValid(); err != nil {
+		return fmt.Errorf("provide a valid ttl: %w", err)
+	}
+	if ttl.AsDuration() < 0 {
+		return fmt.Errorf("provide a non-negative ttl")
+	}
+	return nil
+}
+
 // ValidateCollection - validates a collection key, used for operations on a single document/collection e.g. Get, Set, Delete
 // func ValidateCollection(collection *v1.Collection) error {
 // 	if collection == nil {
@@ -232,3 +288,84 @@ func GetEndRangeValue(value string) string {
 
 // 	return nil
 // }
+
+// ValidateScanLimit - validates the optional limit of a key scan, zero returns all matching keys
+func ValidateScanLimit(limit int32) error {
+	if limit < 0 {
+		return fmt.Errorf("provide a non-negative limit")
+	}
+	return nil
+}
+
+// ComparePrefix - compares a key to the range of keys starting with a prefix,
+// returning -1 if the key sorts before the range, 0 if it's in the range and +1 if it sorts after it
+func ComparePrefix(key string, prefix string) int {
+	if strings.HasPrefix(key, prefix) {
+		return 0
+	}
+	return strings.Compare(key, prefix)
+}
+
+// PrefixEnd - returns the first key sorting after every key starting with prefix, or an empty string if there isn't one
+func PrefixEnd(prefix string) string {
+	runes := []rune(prefix)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == utf8.MaxRune {
+			continue
+		}
+
+		next := runes[i] + 1
+		if next == 0xD800 {
+			// skip the surrogate range, which can't be encoded in a valid string
+			next = 0xE000
+		}
+		return string(runes[:i]) + string(next)
+	}
+	return ""
+}
+
+// PageKeys - applies the start_after, reverse and limit options of a key scan to unordered keys matching its prefix,
+// returning the page of keys in scan order and the continuation token if more keys remain
+func PageKeys(keys []string, req *v1.KvStoreScanKeysRequest) ([]string, string) {
+	page := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if req.StartAfter != "" && (req.Reverse && key >= req.StartAfter || !req.Reverse && key <= req.StartAfter) {
+			continue
+		}
+		page = append(page, key)
+	}
+
+	slices.Sort(page)
+	if req.Reverse {
+		slices.Reverse(page)
+	}
+
+	return LimitKeys(page, req.Limit)
+}
+
+// LimitKeys - truncates keys in scan order to the limit of a key scan,
+// returning the continuation token to resume the scan after them if any keys were removed
+func LimitKeys(keys []string, limit int32) ([]string, string) {
+	if limit <= 0 || len(keys) <= int(limit) {
+		return keys, ""
+	}
+	keys = keys[:limit]
+	return keys, keys[len(keys)-1]
+}
+
+// SendKeys - streams a page of keys, setting the continuation token on the last key
+func SendKeys(stream v1.KvStore_ScanKeysServer, keys []string, continuationToken string) error {
+	for i, key := range keys {
+		resp := &v1.KvStoreScanKeysResponse{
+			Key: key,
+		}
+		if i == len(keys)-1 {
+			resp.ContinuationToken = continuationToken
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,11 @@
 package keyvalue_test
 
 import (
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	document "github.com/nitrictech/nitric/core/pkg/decorators/keyvalue"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 
@@ -47,4 +52,89 @@ var _ = Describe("Document Plugin", func() {
 			})
 		})
 	})
+	When("ValidateValueRefs", func() {
+		When("An invalid key", func() {
+			It("should return error", func() {
+				err := document.ValidateValueRefs([]*kvstorepb.ValueRef{{Store: "users", Key: "1"}, {Store: "users"}})
+				Expect(err.Error()).To(ContainSubstring("provide non-blank key.Id"))
+			})
+		})
+		When("A repeated key", func() {
+			It("should return error", func() {
+				err := document.ValidateValueRefs([]*kvstorepb.ValueRef{{Store: "users", Key: "1"}, {Store: "users", Key: "1"}})
+				Expect(err.Error()).To(ContainSubstring("provide unique keys"))
+			})
+		})
+		When("The same key in different stores", func() {
+			It("should not return error", func() {
+				Expect(document.ValidateValueRefs([]*kvstorepb.ValueRef{{Store: "users", Key: "1"}, {Store: "orders", Key: "1"}})).To(Succeed())
+			})
+		})
+	})
+	When("ValidateConditions", func() {
+		When("Both if_match and if_not_exists", func() {
+			It("should return error", func() {
+				err := document.ValidateConditions("1", true)
+				Expect(err.Error()).To(ContainSubstring("not both"))
+			})
+		})
+		When("A single condition", func() {
+			It("should not return error", func() {
+				Expect(document.ValidateConditions("1", false)).To(Succeed())
+				Expect(document.ValidateConditions("", true)).To(Succeed())
+			})
+		})
+	})
+	When("ValidateTtl", func() {
+		When("Nil ttl", func() {
+			It("should not return error", func() {
+				Expect(document.ValidateTtl(nil)).To(Succeed())
+			})
+		})
+		When("Positive ttl", func() {
+			It("should not return error", func() {
+				Expect(document.ValidateTtl(durationpb.New(time.Hour))).To(Succeed())
+			})
+		})
+		When("Negative ttl", func() {
+			It("should return error", func() {
+				err := document.ValidateTtl(durationpb.New(-time.Hour))
+				Expect(err.Error()).To(ContainSubstring("provide a non-negative ttl"))
+			})
+		})
+	})
+	When("ComparePrefix", func() {
+		It("should order keys relative to the prefix range", func() {
+			Expect(document.ComparePrefix("a", "b")).To(Equal(-1))
+			Expect(document.ComparePrefix("b1", "b")).To(Equal(0))
+			Expect(document.ComparePrefix("c", "b")).To(Equal(1))
+			Expect(document.ComparePrefix("anything", "")).To(Equal(0))
+		})
+	})
+	When("PrefixEnd", func() {
+		It("should return the first key after the prefix range", func() {
+			Expect(document.PrefixEnd("ab")).To(Equal("ac"))
+			Expect(document.PrefixEnd("a" + string(utf8.MaxRune))).To(Equal("b"))
+			Expect(document.PrefixEnd("")).To(Equal(""))
+		})
+	})
+	When("PageKeys", func() {
+		keys := []string{"c", "a", "d", "b"}
+
+		It("should sort and limit keys", func() {
+			page, token := document.PageKeys(keys, &kvstorepb.KvStoreScanKeysRequest{Limit: 2})
+			Expect(page).To(Equal([]string{"a", "b"}))
+			Expect(token).To(Equal("b"))
+		})
+		It("should resume after the start_after key", func() {
+			page, token := document.PageKeys(keys, &kvstorepb.KvStoreScanKeysRequest{Limit: 2, StartAfter: "b"})
+			Expect(page).To(Equal([]string{"c", "d"}))
+			Expect(token).To(BeEmpty())
+		})
+		It("should page in reverse", func() {
+			page, token := document.PageKeys(keys, &kvstorepb.KvStoreScanKeysRequest{Limit: 2, StartAfter: "d", Reverse: true})
+			Expect(page).To(Equal([]string{"c", "b"}))
+			Expect(token).To(Equal("b"))
+		})
+	})
 })
@@ -0,0 +1,118 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
+	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Poll", func() {
+	message := &queuespb.DequeuedMessage{LeaseId: "test-lease"}
+
+	When("Messages are available immediately", func() {
+		It("should receive once and return the messages", func() {
+			calls := 0
+			messages, err := queue.Poll(context.TODO(), time.Minute, time.Millisecond, func() ([]*queuespb.DequeuedMessage, error) {
+				calls++
+				return []*queuespb.DequeuedMessage{message}, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(ConsistOf(message))
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("Messages become available while waiting", func() {
+		It("should keep receiving until messages are returned", func() {
+			calls := 0
+			messages, err := queue.Poll(context.TODO(), time.Minute, time.Millisecond, func() ([]*queuespb.DequeuedMessage, error) {
+				calls++
+				if calls < 3 {
+					return nil, nil
+				}
+				return []*queuespb.DequeuedMessage{message}, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(ConsistOf(message))
+			Expect(calls).To(Equal(3))
+		})
+	})
+
+	When("No wait time is given", func() {
+		It("should receive once", func() {
+			calls := 0
+			messages, err := queue.Poll(context.TODO(), 0, time.Millisecond, func() ([]*queuespb.DequeuedMessage, error) {
+				calls++
+				return nil, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(BeEmpty())
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("The wait time elapses", func() {
+		It("should return no messages after the wait time", func() {
+			start := time.Now()
+			messages, err := queue.Poll(context.TODO(), 50*time.Millisecond, 10*time.Millisecond, func() ([]*queuespb.DequeuedMessage, error) {
+				return nil, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(BeEmpty())
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		})
+	})
+
+	When("The context is cancelled", func() {
+		It("should stop waiting", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			cancel()
+
+			start := time.Now()
+			messages, err := queue.Poll(ctx, time.Minute, time.Second, func() ([]*queuespb.DequeuedMessage, error) {
+				return nil, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(BeEmpty())
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+
+	When("Receiving returns an error", func() {
+		It("should return the error without retrying", func() {
+			calls := 0
+			_, err := queue.Poll(context.TODO(), time.Minute, time.Millisecond, func() ([]*queuespb.DequeuedMessage, error) {
+				calls++
+				return nil, fmt.Errorf("a test error")
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(calls).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
+)
+
+// ValidateLeaseDuration - validates an optional lease duration, which must be positive and at most max when set
+func ValidateLeaseDuration(leaseDuration *durationpb.Duration, max time.Duration) error {
+	if leaseDuration == nil {
+		return nil
+	}
+	if err := leaseDuration.CheckValid(); err != nil {
+		return fmt.Errorf("provide a valid lease duration: %w", err)
+	}
+	if leaseDuration.AsDuration() <= 0 {
+		return fmt.Errorf("provide a positive lease duration")
+	}
+	if leaseDuration.AsDuration() > max {
+		return fmt.Errorf("provide a lease duration of at most %s", max)
+	}
+	return nil
+}
+
+// ValidateDelay - validates an optional message delay, which must be non-negative and at most max when set
+func ValidateDelay(delay *durationpb.Duration, max time.Duration) error {
+	return validateNonNegative("delay", delay, max)
+}
+
+// ValidateWait - validates an optional dequeue wait time, which must be non-negative and at most max when set
+func ValidateWait(wait *durationpb.Duration, max time.Duration) error {
+	return validateNonNegative("wait time", wait, max)
+}
+
+func validateNonNegative(name string, d *durationpb.Duration, max time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return fmt.Errorf("provide a valid %s: %w", name, err)
+	}
+	if d.AsDuration() < 0 {
+		return fmt.Errorf("provide a non-negative %s", name)
+	}
+	if d.AsDuration() > max {
+		return fmt.Errorf("provide a %s of at most %s", name, max)
+	}
+	return nil
+}
+
+// Poll - calls receive until it returns messages or an error, or until wait has elapsed, sleeping interval between attempts.
+// receive is always called at least once, and polling ends early with no messages if ctx is done.
+func Poll(ctx context.Context, wait time.Duration, interval time.Duration, receive func() ([]*queuespb.DequeuedMessage, error)) ([]*queuespb.DequeuedMessage, error) {
+	deadline := time.Now().Add(wait)
+
+	for {
+		messages, err := receive()
+		if err != nil || len(messages) > 0 {
+			return messages, err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return messages, nil
+		}
+
+		timer := time.NewTimer(min(interval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return messages, nil
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue_test
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue Validation", func() {
+	When("ValidateLeaseDuration", func() {
+		When("Nil lease duration", func() {
+			It("should not return error", func() {
+				Expect(queue.ValidateLeaseDuration(nil, time.Minute)).To(Succeed())
+			})
+		})
+		When("Zero lease duration", func() {
+			It("should return error", func() {
+				err := queue.ValidateLeaseDuration(durationpb.New(0), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("provide a positive lease duration"))
+			})
+		})
+		When("Lease duration over the maximum", func() {
+			It("should return error", func() {
+				err := queue.ValidateLeaseDuration(durationpb.New(time.Hour), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("at most 1m0s"))
+			})
+		})
+	})
+	When("ValidateDelay", func() {
+		When("Zero delay", func() {
+			It("should not return error", func() {
+				Expect(queue.ValidateDelay(durationpb.New(0), time.Minute)).To(Succeed())
+			})
+		})
+		When("Negative delay", func() {
+			It("should return error", func() {
+				err := queue.ValidateDelay(durationpb.New(-time.Second), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("provide a non-negative delay"))
+			})
+		})
+		When("Delay over the maximum", func() {
+			It("should return error", func() {
+				err := queue.ValidateDelay(durationpb.New(time.Hour), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("at most 1m0s"))
+			})
+		})
+	})
+	When("ValidateWait", func() {
+		When("Nil wait time", func() {
+			It("should not return error", func() {
+				Expect(queue.ValidateWait(nil, time.Minute)).To(Succeed())
+			})
+		})
+		When("Negative wait time", func() {
+			It("should return error", func() {
+				err := queue.ValidateWait(durationpb.New(-time.Second), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("provide a non-negative wait time"))
+			})
+		})
+		When("Wait time over the maximum", func() {
+			It("should return error", func() {
+				err := queue.ValidateWait(durationpb.New(time.Hour), time.Minute)
+				Expect(err.Error()).To(ContainSubstring("wait time of at most 1m0s"))
+			})
+		})
+	})
+})
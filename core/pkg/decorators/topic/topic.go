@@ -0,0 +1,49 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topic
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ReservedAttributePrefix - attributes with this prefix are reserved for use by nitric
+const ReservedAttributePrefix = "x-nitric-"
+
+// ValidateAttributes - validates message attributes, which must have non-empty keys without the reserved prefix, at most max attributes can be set
+func ValidateAttributes(attributes map[string]string, max int) error {
+	if len(attributes) > max {
+		return fmt.Errorf("provide at most %d attributes", max)
+	}
+	for key := range attributes {
+		if key == "" {
+			return fmt.Errorf("provide a non-empty attribute key")
+		}
+		if strings.HasPrefix(strings.ToLower(key), ReservedAttributePrefix) {
+			return fmt.Errorf("attribute %s uses the reserved prefix %s", key, ReservedAttributePrefix)
+		}
+	}
+	return nil
+}
+
+// ValidateOrderingKey - validates an optional ordering key, which can't be used with a delay since delayed messages may be published out of order
+func ValidateOrderingKey(orderingKey string, delay *durationpb.Duration) error {
+	if orderingKey != "" && delay.AsDuration() > 0 {
+		return fmt.Errorf("an ordering key can't be used with a delay")
+	}
+	return nil
+}
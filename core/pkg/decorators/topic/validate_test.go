@@ -0,0 +1,72 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topic_test
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators/topic"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Topic Validation", func() {
+	When("ValidateAttributes", func() {
+		When("No attributes", func() {
+			It("should not return error", func() {
+				Expect(topic.ValidateAttributes(nil, 10)).To(Succeed())
+			})
+		})
+		When("Too many attributes", func() {
+			It("should return error", func() {
+				err := topic.ValidateAttributes(map[string]string{"a": "1", "b": "2"}, 1)
+				Expect(err.Error()).To(ContainSubstring("provide at most 1 attributes"))
+			})
+		})
+		When("An empty attribute key", func() {
+			It("should return error", func() {
+				err := topic.ValidateAttributes(map[string]string{"": "1"}, 10)
+				Expect(err.Error()).To(ContainSubstring("provide a non-empty attribute key"))
+			})
+		})
+		When("A reserved attribute key", func() {
+			It("should return error", func() {
+				err := topic.ValidateAttributes(map[string]string{"X-Nitric-Topic": "test"}, 10)
+				Expect(err.Error()).To(ContainSubstring("reserved prefix"))
+			})
+		})
+	})
+	When("ValidateOrderingKey", func() {
+		When("An ordering key without a delay", func() {
+			It("should not return error", func() {
+				Expect(topic.ValidateOrderingKey("key", nil)).To(Succeed())
+			})
+		})
+		When("A delay without an ordering key", func() {
+			It("should not return error", func() {
+				Expect(topic.ValidateOrderingKey("", durationpb.New(time.Minute))).To(Succeed())
+			})
+		})
+		When("An ordering key with a delay", func() {
+			It("should return error", func() {
+				err := topic.ValidateOrderingKey("key", durationpb.New(time.Minute))
+				Expect(err.Error()).To(ContainSubstring("can't be used with a delay"))
+			})
+		})
+	})
+})
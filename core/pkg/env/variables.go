@@ -21,6 +21,26 @@ var (
 	WORKER_TIMEOUT  = GetEnv("WORKER_TIMEOUT", "10")
 	SERVICE_ADDRESS = GetEnv("SERVICE_ADDRESS", "127.0.0.1:")
 	LOG_LEVEL       = GetEnv("LOG_LEVEL", "INFO")
+	// The format of log lines, can either be text, json or logfmt
+	LOG_FORMAT = GetEnv("LOG_FORMAT", "text")
+	// The address the Prometheus metrics endpoint listens on, metrics are disabled when empty
+	METRICS_ADDRESS = GetEnv("METRICS_ADDRESS", "")
+	// The address the health, readiness and liveness endpoints listen on, health endpoints are disabled when empty
+	HEALTH_ADDRESS = GetEnv("HEALTH_ADDRESS", "")
+	// The strategy used to distribute requests across workers registered for the same trigger, can either be round-robin or least-in-flight
+	WORKER_LOAD_BALANCING = GetEnv("WORKER_LOAD_BALANCING", "round-robin")
+	// How messages are delivered to the subscribers of a topic, can either be all or retry-failed
+	TOPIC_DELIVERY_MODE = GetEnv("TOPIC_DELIVERY_MODE", "all")
+	// The number of times failed subscribers are retried when the topic delivery mode is retry-failed
+	TOPIC_DELIVERY_RETRIES = GetEnv("TOPIC_DELIVERY_RETRIES", "3")
+	// Whether the child process is restarted when it exits, can either be never, on-failure or always
+	CHILD_RESTART_POLICY = GetEnv("CHILD_RESTART_POLICY", "never")
+	// Whether pre commands are restarted when they exit, can either be never, on-failure or always
+	PRE_COMMAND_RESTART_POLICY = GetEnv("PRE_COMMAND_RESTART_POLICY", "never")
+	// Seconds child processes have to exit after SIGTERM before they're killed
+	CHILD_STOP_GRACE_PERIOD = GetEnv("CHILD_STOP_GRACE_PERIOD", "10")
+	// Seconds to wait for requests in flight to workers to complete when the server stops
+	DRAIN_TIMEOUT = GetEnv("DRAIN_TIMEOUT", "10")
 	// The execution type of the nitric execution unit, can either be job or service
 	EXECUTION_TYPE = GetEnv("NITRIC_EXECUTION_TYPE", "service")
 )
@@ -0,0 +1,53 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	local_gateway "github.com/nitrictech/nitric/core/pkg/local/gateway"
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/apis"
+)
+
+// LocalApiGatewayProvider - serves APIs through the local gateway
+// API details include the URL of the API on the local gateway.
+type LocalApiGatewayProvider struct {
+	*apis.RouteWorkerManager
+	gatewayUrl string
+}
+
+var _ apis.ApiRequestHandler = (*LocalApiGatewayProvider)(nil)
+
+// ApiDetails - return the registered routes and local gateway URL of an API
+func (l *LocalApiGatewayProvider) ApiDetails(ctx context.Context, req *apispb.ApiDetailsRequest) (*apispb.ApiDetailsResponse, error) {
+	details, err := l.RouteWorkerManager.ApiDetails(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	details.Url = fmt.Sprintf("%s%s/%s", l.gatewayUrl, local_gateway.ApiRoute, req.ApiName)
+
+	return details, nil
+}
+
+// New - create a new local API provider, APIs are reachable under gatewayUrl
+func New(gatewayUrl string) *LocalApiGatewayProvider {
+	return &LocalApiGatewayProvider{
+		RouteWorkerManager: apis.New(),
+		gatewayUrl:         gatewayUrl,
+	}
+}
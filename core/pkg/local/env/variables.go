@@ -0,0 +1,26 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import "github.com/nitrictech/nitric/core/pkg/env"
+
+// Directory used to persist local buckets and key value stores
+var NITRIC_LOCAL_DATA_DIR = env.GetEnv("NITRIC_LOCAL_DATA_DIR", ".nitric/local")
+
+// Address of the local gateway
+var GATEWAY_ADDRESS = env.GetEnv("GATEWAY_ADDRESS", ":9001")
+
+// Default visibility timeout of dequeued messages in seconds
+var NITRIC_LOCAL_QUEUE_LEASE_SECONDS = env.GetEnv("NITRIC_LOCAL_QUEUE_LEASE_SECONDS", "30")
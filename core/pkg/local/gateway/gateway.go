@@ -0,0 +1,350 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/nitrictech/nitric/core/pkg/gateway"
+	"github.com/nitrictech/nitric/core/pkg/local/storage"
+	"github.com/nitrictech/nitric/core/pkg/local/websocket"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+const (
+	ApiRoute      = "/x-nitric-api"
+	TopicRoute    = "/x-nitric-topic"
+	ScheduleRoute = "/x-nitric-schedule"
+)
+
+// LocalGateway - a HTTP gateway for running nitric services locally
+// APIs are served under /x-nitric-api/{api}/, topics and schedules can be triggered manually under
+// /x-nitric-topic/{name} and /x-nitric-schedule/{name}, and all other requests are proxied to the http worker if one is registered.
+type LocalGateway struct {
+	gateway.UnimplementedGatewayPlugin
+	address    string
+	server     *fasthttp.Server
+	storage    storagepb.StorageServer
+	websockets *websocket.LocalWebsocketService
+}
+
+var _ gateway.GatewayService = (*LocalGateway)(nil)
+
+// splitRoute returns the name segment following the route prefix and the remaining path
+func splitRoute(path string, prefix string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(path, prefix+"/")
+	if !ok {
+		return "", "", false
+	}
+
+	name, remaining, _ := strings.Cut(rest, "/")
+	if name == "" {
+		return "", "", false
+	}
+
+	return name, "/" + remaining, true
+}
+
+func (g *LocalGateway) handleApi(rc *fasthttp.RequestCtx, opts *gateway.GatewayStartOpts, apiName string, path string) {
+	headers := map[string]*apispb.HeaderValue{}
+	rc.Request.Header.VisitAll(func(key []byte, val []byte) {
+		k := string(key)
+
+		if headers[k] == nil {
+			headers[k] = &apispb.HeaderValue{}
+		}
+
+		headers[k].Value = append(headers[k].Value, string(val))
+	})
+
+	query := map[string]*apispb.QueryValue{}
+	rc.QueryArgs().VisitAll(func(key []byte, val []byte) {
+		k := string(key)
+
+		if query[k] == nil {
+			query[k] = &apispb.QueryValue{}
+		}
+
+		query[k].Value = append(query[k].Value, string(val))
+	})
+
+	resp, err := opts.ApiPlugin.HandleRequest(rc, apiName, &apispb.ServerMessage{
+		Content: &apispb.ServerMessage_HttpRequest{
+			HttpRequest: &apispb.HttpRequest{
+				Method:      string(rc.Request.Header.Method()),
+				Path:        path,
+				Headers:     headers,
+				QueryParams: query,
+				Body:        rc.Request.Body(),
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorf("error handling request for api %s: %v", apiName, err)
+		if workers.IsWorkerTimeout(err) {
+			rc.Error("Worker did not respond before the deadline", fasthttp.StatusGatewayTimeout)
+			return
+		}
+		rc.Error("Unable to get worker to handle request", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	httpResponse := resp.GetHttpResponse()
+	if httpResponse == nil {
+		rc.Error("received invalid response type from worker", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	for k, v := range httpResponse.Headers {
+		for _, val := range v.Value {
+			rc.Response.Header.Add(k, val)
+		}
+	}
+
+	// Avoid content length header duplication
+	rc.Response.Header.Del("Content-Length")
+	rc.Response.SetStatusCode(int(httpResponse.Status))
+	rc.Response.SetBody(httpResponse.Body)
+}
+
+// isJSON returns true if the content type is empty or a JSON media type
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// topicMessageFromBody converts a request body to a topic message.
+// JSON objects are sent as struct payloads, other bodies are sent as bytes payloads unless the content type is JSON.
+func topicMessageFromBody(contentType string, body []byte) (*topicspb.TopicMessage, error) {
+	payload := map[string]any{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			if isJSON(contentType) {
+				return nil, fmt.Errorf("message body must be a JSON object")
+			}
+
+			return &topicspb.TopicMessage{
+				Content: &topicspb.TopicMessage_BytesPayload{
+					BytesPayload: body,
+				},
+				ContentType: contentType,
+			}, nil
+		}
+	}
+
+	structPayload, err := structpb.NewStruct(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert message body to struct")
+	}
+
+	return &topicspb.TopicMessage{
+		Content: &topicspb.TopicMessage_StructPayload{
+			StructPayload: structPayload,
+		},
+	}, nil
+}
+
+func (g *LocalGateway) handleTopic(rc *fasthttp.RequestCtx, opts *gateway.GatewayStartOpts, topicName string) {
+	message, err := topicMessageFromBody(string(rc.Request.Header.ContentType()), rc.Request.Body())
+	if err != nil {
+		rc.Error(err.Error(), fasthttp.StatusBadRequest)
+		return
+	}
+
+	resp, err := opts.TopicsListenerPlugin.HandleRequest(rc, &topicspb.ServerMessage{
+		Content: &topicspb.ServerMessage_MessageRequest{
+			MessageRequest: &topicspb.MessageRequest{
+				TopicName: topicName,
+				Message:   message,
+			},
+		},
+	})
+	if err != nil {
+		if workers.IsWorkerTimeout(err) {
+			rc.Error("Event handler did not respond before the deadline", fasthttp.StatusGatewayTimeout)
+			return
+		}
+		rc.Error(fmt.Sprintf("Error handling event %v", err), fasthttp.StatusInternalServerError)
+		return
+	}
+
+	if !resp.GetMessageResponse().GetSuccess() {
+		rc.Error("Event handler returned success false", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	rc.SuccessString("text/plain", "success")
+}
+
+func (g *LocalGateway) handleSchedule(rc *fasthttp.RequestCtx, opts *gateway.GatewayStartOpts, scheduleName string) {
+	_, err := opts.SchedulesPlugin.HandleRequest(rc, &schedulespb.ServerMessage{
+		Content: &schedulespb.ServerMessage_IntervalRequest{
+			IntervalRequest: &schedulespb.IntervalRequest{
+				ScheduleName: scheduleName,
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorf("could not handle trigger for schedule %s: %s", scheduleName, err.Error())
+		if workers.IsWorkerTimeout(err) {
+			rc.Error("schedule handler did not respond before the deadline", fasthttp.StatusGatewayTimeout)
+			return
+		}
+		rc.Error("could not handle trigger", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	rc.SuccessString("text/plain", "success")
+}
+
+// handlePreSignedBlob serves the URLs returned by the local storage plugin's PreSignUrl
+func (g *LocalGateway) handlePreSignedBlob(rc *fasthttp.RequestCtx, bucketName string, key string) {
+	expires, err := strconv.ParseInt(string(rc.QueryArgs().Peek("expires")), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		rc.Error("URL has expired", fasthttp.StatusForbidden)
+		return
+	}
+
+	operation := string(rc.QueryArgs().Peek("operation"))
+	key = strings.TrimPrefix(key, "/")
+
+	switch {
+	case rc.IsGet() && operation == storagepb.StoragePreSignUrlRequest_READ.String():
+		resp, err := g.storage.Read(rc, &storagepb.StorageReadRequest{
+			BucketName: bucketName,
+			Key:        key,
+		})
+		if err != nil {
+			rc.Error(err.Error(), fasthttp.StatusNotFound)
+			return
+		}
+
+		if stat, err := g.storage.Stat(rc, &storagepb.StorageStatRequest{
+			BucketName: bucketName,
+			Key:        key,
+		}); err == nil {
+			rc.SetContentType(stat.Blob.ContentType)
+			if stat.Blob.CacheControl != "" {
+				rc.Response.Header.Set(fasthttp.HeaderCacheControl, stat.Blob.CacheControl)
+			}
+		}
+
+		rc.SetBody(resp.Body)
+	case rc.IsPut() && operation == storagepb.StoragePreSignUrlRequest_WRITE.String():
+		_, err := g.storage.Write(rc, &storagepb.StorageWriteRequest{
+			BucketName:   bucketName,
+			Key:          key,
+			Body:         rc.Request.Body(),
+			ContentType:  string(rc.Request.Header.ContentType()),
+			CacheControl: string(rc.Request.Header.Peek(fasthttp.HeaderCacheControl)),
+		})
+		if err != nil {
+			rc.Error(err.Error(), fasthttp.StatusInternalServerError)
+			return
+		}
+
+		rc.SetStatusCode(fasthttp.StatusOK)
+	default:
+		rc.Error("Method Not Allowed", fasthttp.StatusMethodNotAllowed)
+	}
+}
+
+func (g *LocalGateway) handleHttpProxy(rc *fasthttp.RequestCtx, opts *gateway.GatewayStartOpts) {
+	if opts.HttpPlugin == nil || opts.HttpPlugin.WorkerCount() == 0 {
+		rc.Error("Not Found", fasthttp.StatusNotFound)
+		return
+	}
+
+	resp, err := opts.HttpPlugin.HandleRequest(&rc.Request)
+	if err != nil {
+		logger.Errorf("error handling request: %s", err)
+		rc.Error("Internal Server Error", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	resp.CopyTo(&rc.Response)
+}
+
+func (g *LocalGateway) newHandler(opts *gateway.GatewayStartOpts) fasthttp.RequestHandler {
+	return func(rc *fasthttp.RequestCtx) {
+		path := string(rc.Path())
+
+		if name, rest, ok := splitRoute(path, ApiRoute); ok {
+			g.handleApi(rc, opts, name, rest)
+		} else if name, _, ok := splitRoute(path, TopicRoute); ok && rc.IsPost() {
+			g.handleTopic(rc, opts, name)
+		} else if name, _, ok := splitRoute(path, ScheduleRoute); ok && rc.IsPost() {
+			g.handleSchedule(rc, opts, name)
+		} else if name, _, ok := splitRoute(path, websocket.SocketRoute); ok && g.websockets != nil {
+			g.websockets.Serve(rc, name, opts.WebsocketListenerPlugin)
+		} else if name, rest, ok := splitRoute(path, storage.PreSignRoute); ok && g.storage != nil {
+			g.handlePreSignedBlob(rc, name, rest)
+		} else {
+			g.handleHttpProxy(rc, opts)
+		}
+	}
+}
+
+// Start the local gateway and block until it's stopped
+func (g *LocalGateway) Start(opts *gateway.GatewayStartOpts) error {
+	g.server = &fasthttp.Server{
+		CloseOnShutdown: true,
+		Handler:         g.newHandler(opts),
+		ReadBufferSize:  8192,
+	}
+
+	logger.Infof("Local gateway listening on %s", g.address)
+
+	return g.server.ListenAndServe(g.address)
+}
+
+// Stop the local gateway
+func (g *LocalGateway) Stop() error {
+	if g.server != nil {
+		return g.server.Shutdown()
+	}
+
+	return nil
+}
+
+// New creates a new local gateway, serving pre-signed storage URLs and websocket connections for the given plugins
+func New(address string, storage storagepb.StorageServer, websockets *websocket.LocalWebsocketService) *LocalGateway {
+	return &LocalGateway{
+		address:    address,
+		storage:    storage,
+		websockets: websockets,
+	}
+}
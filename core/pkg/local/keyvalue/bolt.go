@@ -0,0 +1,513 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	document "github.com/nitrictech/nitric/core/pkg/decorators/keyvalue"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
+)
+
+var (
+	errKeyNotFound        = errors.New("key not found")
+	errPreconditionFailed = errors.New("precondition failed")
+)
+
+// BoltKeyValueService - a BoltDB implementation of the Nitric KvStore Service
+// Each store is a bolt bucket and values are stored as JSON encoded structs.
+type BoltKeyValueService struct {
+	db  *bolt.DB
+	now func() time.Time
+}
+
+var _ kvstorepb.KvStoreServer = (*BoltKeyValueService)(nil)
+
+// expiryBucketName returns the name of the bucket the expiry times of values in a store are kept in.
+// The .nitric- prefix keeps it apart from the stores themselves.
+func expiryBucketName(store string) []byte {
+	return []byte(".nitric-expiry-" + store)
+}
+
+// versionBucketName returns the name of the bucket the versions of values in a store are kept in
+func versionBucketName(store string) []byte {
+	return []byte(".nitric-version-" + store)
+}
+
+// version returns the version of an existing value, values set before versions were recorded have version 0
+func version(tx *bolt.Tx, store string, key []byte) string {
+	versions := tx.Bucket(versionBucketName(store))
+	if versions == nil {
+		return "0"
+	}
+
+	version := versions.Get(key)
+	if version == nil {
+		return "0"
+	}
+
+	return string(version)
+}
+
+// expired returns true if the value for key in store has a ttl that has elapsed
+func (s *BoltKeyValueService) expired(tx *bolt.Tx, store string, key []byte) bool {
+	expiries := tx.Bucket(expiryBucketName(store))
+	if expiries == nil {
+		return false
+	}
+
+	expiry := expiries.Get(key)
+	if expiry == nil {
+		return false
+	}
+
+	return !s.now().Before(time.Unix(0, int64(binary.BigEndian.Uint64(expiry))))
+}
+
+// GetValue retrieves a value from a store
+func (s *BoltKeyValueService) GetValue(ctx context.Context, req *kvstorepb.KvStoreGetValueRequest) (*kvstorepb.KvStoreGetValueResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.GetValue")
+
+	if err := document.ValidateValueRef(req.Ref); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid key", err)
+	}
+
+	content := &structpb.Struct{}
+	valueVersion := ""
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		store := tx.Bucket([]byte(req.Ref.Store))
+		if store == nil {
+			return errKeyNotFound
+		}
+
+		value := store.Get([]byte(req.Ref.Key))
+		if value == nil || s.expired(tx, req.Ref.Store, []byte(req.Ref.Key)) {
+			return errKeyNotFound
+		}
+
+		valueVersion = version(tx, req.Ref.Store, []byte(req.Ref.Key))
+
+		return protojson.Unmarshal(value, content)
+	})
+	if err != nil {
+		if errors.Is(err, errKeyNotFound) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("key %s not found in store %s", req.Ref.Key, req.Ref.Store), err)
+		}
+
+		return nil, newErr(codes.Internal, fmt.Sprintf("error retrieving value with key %s from store %s", req.Ref.Key, req.Ref.Store), err)
+	}
+
+	return &kvstorepb.KvStoreGetValueResponse{
+		Value: &kvstorepb.Value{
+			Ref:     req.Ref,
+			Content: content,
+			Version: valueVersion,
+		},
+	}, nil
+}
+
+// putValue stores a marshalled value, subject to the optional set conditions
+func (s *BoltKeyValueService) putValue(tx *bolt.Tx, ref *kvstorepb.ValueRef, value []byte, ttl time.Duration, ifMatch string, ifNotExists bool) error {
+	store, err := tx.CreateBucketIfNotExists([]byte(ref.Store))
+	if err != nil {
+		return err
+	}
+
+	key := []byte(ref.Key)
+	exists := store.Get(key) != nil && !s.expired(tx, ref.Store, key)
+
+	if ifNotExists && exists {
+		return errPreconditionFailed
+	}
+
+	if ifMatch != "" && (!exists || version(tx, ref.Store, key) != ifMatch) {
+		return errPreconditionFailed
+	}
+
+	if err := store.Put(key, value); err != nil {
+		return err
+	}
+
+	versions, err := tx.CreateBucketIfNotExists(versionBucketName(ref.Store))
+	if err != nil {
+		return err
+	}
+
+	nextVersion, err := store.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	if err := versions.Put(key, []byte(strconv.FormatUint(nextVersion, 10))); err != nil {
+		return err
+	}
+
+	if ttl == 0 {
+		// overwriting a value clears any previous expiry
+		if expiries := tx.Bucket(expiryBucketName(ref.Store)); expiries != nil {
+			return expiries.Delete(key)
+		}
+
+		return nil
+	}
+
+	expiries, err := tx.CreateBucketIfNotExists(expiryBucketName(ref.Store))
+	if err != nil {
+		return err
+	}
+
+	expiry := binary.BigEndian.AppendUint64(nil, uint64(s.now().Add(ttl).UnixNano()))
+
+	return expiries.Put(key, expiry)
+}
+
+// deleteValue removes a value, subject to the optional version condition
+func (s *BoltKeyValueService) deleteValue(tx *bolt.Tx, ref *kvstorepb.ValueRef, ifMatch string) error {
+	key := []byte(ref.Key)
+
+	store := tx.Bucket([]byte(ref.Store))
+	if store == nil {
+		if ifMatch != "" {
+			return errPreconditionFailed
+		}
+
+		return nil
+	}
+
+	if ifMatch != "" {
+		exists := store.Get(key) != nil && !s.expired(tx, ref.Store, key)
+		if !exists || version(tx, ref.Store, key) != ifMatch {
+			return errPreconditionFailed
+		}
+	}
+
+	for _, bucketName := range [][]byte{expiryBucketName(ref.Store), versionBucketName(ref.Store)} {
+		if bucket := tx.Bucket(bucketName); bucket != nil {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return store.Delete(key)
+}
+
+// SetValue creates or overwrites a value in a store
+func (s *BoltKeyValueService) SetValue(ctx context.Context, req *kvstorepb.KvStoreSetValueRequest) (*kvstorepb.KvStoreSetValueResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.SetValue")
+
+	if err := document.ValidateValueRef(req.Ref); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid key", err)
+	}
+
+	if req.Content == nil {
+		return nil, newErr(codes.InvalidArgument, "value content must not be nil", nil)
+	}
+
+	if err := document.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid ttl", err)
+	}
+
+	if err := document.ValidateConditions(req.IfMatch, req.IfNotExists); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid conditions", err)
+	}
+
+	value, err := protojson.Marshal(req.Content)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "failed to marshal content", err)
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return s.putValue(tx, req.Ref, value, req.Ttl.AsDuration(), req.IfMatch, req.IfNotExists)
+	})
+	if err != nil {
+		if errors.Is(err, errPreconditionFailed) {
+			return nil, newErr(codes.FailedPrecondition, fmt.Sprintf("value with key %s in store %s does not satisfy the set conditions", req.Ref.Key, req.Ref.Store), err)
+		}
+
+		return nil, newErr(codes.Internal, "unable to set value", err)
+	}
+
+	return &kvstorepb.KvStoreSetValueResponse{}, nil
+}
+
+// DeleteKey removes a key and its value from a store
+func (s *BoltKeyValueService) DeleteKey(ctx context.Context, req *kvstorepb.KvStoreDeleteKeyRequest) (*kvstorepb.KvStoreDeleteKeyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.DeleteKey")
+
+	if err := document.ValidateValueRef(req.Ref); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid key", err)
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return s.deleteValue(tx, req.Ref, req.IfMatch)
+	})
+	if err != nil {
+		if errors.Is(err, errPreconditionFailed) {
+			return nil, newErr(codes.FailedPrecondition, fmt.Sprintf("value with key %s in store %s does not match version %s", req.Ref.Key, req.Ref.Store, req.IfMatch), err)
+		}
+
+		return nil, newErr(codes.Internal, fmt.Sprintf("error deleting %s item %s", req.Ref.Store, req.Ref.Key), err)
+	}
+
+	return &kvstorepb.KvStoreDeleteKeyResponse{}, nil
+}
+
+// ScanKeys streams the keys in a store that start with the requested prefix in lexical order, a page at a time if a limit is set
+func (s *BoltKeyValueService) ScanKeys(req *kvstorepb.KvStoreScanKeysRequest, stream kvstorepb.KvStore_ScanKeysServer) error {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.ScanKeys")
+
+	if req.Store.GetName() == "" {
+		return newErr(codes.InvalidArgument, "store name is required", nil)
+	}
+
+	if err := document.ValidateScanLimit(req.Limit); err != nil {
+		return newErr(codes.InvalidArgument, "invalid limit", err)
+	}
+
+	// keys are collected first to avoid holding a read transaction open while streaming
+	keys := []string{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		store := tx.Bucket([]byte(req.Store.Name))
+		if store == nil {
+			return nil
+		}
+
+		c := store.Cursor()
+		k, next := seekScanStart(c, req)
+
+		for ; k != nil; k, _ = next() {
+			key := string(k)
+
+			// the cursor starts at or beyond the start_after key, so it's skipped if landed on
+			if key == req.StartAfter {
+				continue
+			}
+
+			if cmp := document.ComparePrefix(key, req.Prefix); cmp != 0 {
+				if (cmp > 0) != req.Reverse {
+					break
+				}
+				continue
+			}
+
+			if s.expired(tx, req.Store.Name, k) {
+				continue
+			}
+
+			keys = append(keys, key)
+
+			// one key beyond the limit is collected to determine if more keys remain
+			if req.Limit > 0 && len(keys) > int(req.Limit) {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to retrieve keys", err)
+	}
+
+	keys, continuationToken := document.LimitKeys(keys, req.Limit)
+
+	if err := document.SendKeys(stream, keys, continuationToken); err != nil {
+		return newErr(codes.Internal, "failed to send response", err)
+	}
+
+	return nil
+}
+
+// seekScanStart positions a cursor at the first key a scan could return, returning the key and the function to advance the cursor in scan order
+func seekScanStart(c *bolt.Cursor, req *kvstorepb.KvStoreScanKeysRequest) ([]byte, func() ([]byte, []byte)) {
+	if !req.Reverse {
+		start := req.Prefix
+		if req.StartAfter > start {
+			start = req.StartAfter
+		}
+
+		k, _ := c.Seek([]byte(start))
+		return k, c.Next
+	}
+
+	// scanning in reverse starts before the lower of the start_after key and the end of the prefix range
+	end := document.PrefixEnd(req.Prefix)
+	if req.StartAfter != "" && (end == "" || req.StartAfter < end) {
+		end = req.StartAfter
+	}
+
+	if end == "" {
+		k, _ := c.Last()
+		return k, c.Prev
+	}
+
+	if k, _ := c.Seek([]byte(end)); k == nil {
+		k, _ = c.Last()
+		return k, c.Prev
+	}
+
+	k, _ := c.Prev()
+	return k, c.Prev
+}
+
+// GetValues retrieves multiple values, reporting a result for each key
+func (s *BoltKeyValueService) GetValues(ctx context.Context, req *kvstorepb.KvStoreGetValuesRequest) (*kvstorepb.KvStoreGetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.GetValues")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid keys", err)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, 0, len(req.Refs))
+	for _, ref := range req.Refs {
+		resp, err := s.GetValue(ctx, &kvstorepb.KvStoreGetValueRequest{Ref: ref})
+		results = append(results, document.BatchResult(ref, resp.GetValue(), err))
+	}
+
+	return &kvstorepb.KvStoreGetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// SetValues creates or overwrites multiple values in a single transaction, reporting a result for each key
+func (s *BoltKeyValueService) SetValues(ctx context.Context, req *kvstorepb.KvStoreSetValuesRequest) (*kvstorepb.KvStoreSetValuesResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.SetValues")
+
+	refs := make([]*kvstorepb.ValueRef, 0, len(req.Values))
+	for _, value := range req.Values {
+		refs = append(refs, value.GetRef())
+	}
+
+	if err := document.ValidateValueRefs(refs); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid keys", err)
+	}
+
+	if err := document.ValidateTtl(req.Ttl); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid ttl", err)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, len(req.Values))
+	marshalled := make([][]byte, len(req.Values))
+	for i, value := range req.Values {
+		if value.Content == nil {
+			results[i] = document.BatchResult(value.Ref, nil, newErr(codes.InvalidArgument, "value content must not be nil", nil))
+			continue
+		}
+
+		content, err := protojson.Marshal(value.Content)
+		if err != nil {
+			results[i] = document.BatchResult(value.Ref, nil, newErr(codes.InvalidArgument, "failed to marshal content", err))
+			continue
+		}
+
+		marshalled[i] = content
+	}
+
+	// values are written in a single transaction, so they all share its outcome
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for i, value := range req.Values {
+			if results[i] != nil {
+				continue
+			}
+
+			if err := s.putValue(tx, value.Ref, marshalled[i], req.Ttl.AsDuration(), "", false); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		err = newErr(codes.Internal, "unable to set values", err)
+	}
+
+	for i, value := range req.Values {
+		if results[i] == nil {
+			results[i] = document.BatchResult(value.Ref, nil, err)
+		}
+	}
+
+	return &kvstorepb.KvStoreSetValuesResponse{
+		Results: results,
+	}, nil
+}
+
+// DeleteKeys removes multiple keys and their values in a single transaction, reporting a result for each key
+func (s *BoltKeyValueService) DeleteKeys(ctx context.Context, req *kvstorepb.KvStoreDeleteKeysRequest) (*kvstorepb.KvStoreDeleteKeysResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("BoltKeyValueService.DeleteKeys")
+
+	if err := document.ValidateValueRefs(req.Refs); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid keys", err)
+	}
+
+	// keys are deleted in a single transaction, so they all share its outcome
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, ref := range req.Refs {
+			if err := s.deleteValue(tx, ref, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		err = newErr(codes.Internal, "unable to delete keys", err)
+	}
+
+	results := make([]*kvstorepb.KvStoreBatchResult, 0, len(req.Refs))
+	for _, ref := range req.Refs {
+		results = append(results, document.BatchResult(ref, nil, err))
+	}
+
+	return &kvstorepb.KvStoreDeleteKeysResponse{
+		Results: results,
+	}, nil
+}
+
+// Close the underlying database
+func (s *BoltKeyValueService) Close() error {
+	return s.db.Close()
+}
+
+// New creates a new BoltDB key value plugin, persisting stores to the database file at path
+func New(path string) (*BoltKeyValueService, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("error creating key value directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening key value database %s: %w", path, err)
+	}
+
+	return &BoltKeyValueService{
+		db:  db,
+		now: time.Now,
+	}, nil
+}
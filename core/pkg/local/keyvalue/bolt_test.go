@@ -0,0 +1,392 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
+)
+
+type scanKeysStream struct {
+	grpc.ServerStream
+	keys              []string
+	continuationToken string
+}
+
+func (s *scanKeysStream) Send(resp *kvstorepb.KvStoreScanKeysResponse) error {
+	s.keys = append(s.keys, resp.Key)
+	s.continuationToken = resp.ContinuationToken
+	return nil
+}
+
+var _ = Describe("BoltKeyValueService", func() {
+	var (
+		dataDir string
+		service *BoltKeyValueService
+	)
+
+	setValue := func(key string, content map[string]interface{}) {
+		s, err := structpb.NewStruct(content)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = service.SetValue(context.TODO(), &kvstorepb.KvStoreSetValueRequest{
+			Ref:     &kvstorepb.ValueRef{Store: "test-store", Key: key},
+			Content: s,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dataDir, err = os.MkdirTemp("", "nitric-kv-*")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		service, err = New(filepath.Join(dataDir, "kv.db"))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(service.Close()).To(Succeed())
+		os.RemoveAll(dataDir)
+	})
+
+	Context("GetValue", func() {
+		When("the key exists", func() {
+			It("should return the stored value", func() {
+				setValue("test-key", map[string]interface{}{"name": "test"})
+
+				resp, err := service.GetValue(context.TODO(), &kvstorepb.KvStoreGetValueRequest{
+					Ref: &kvstorepb.ValueRef{Store: "test-store", Key: "test-key"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Value.Content.AsMap()).To(Equal(map[string]interface{}{"name": "test"}))
+			})
+		})
+
+		When("the key does not exist", func() {
+			It("should return a not found error", func() {
+				_, err := service.GetValue(context.TODO(), &kvstorepb.KvStoreGetValueRequest{
+					Ref: &kvstorepb.ValueRef{Store: "test-store", Key: "missing-key"},
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("DeleteKey", func() {
+		When("the key exists", func() {
+			It("should remove the key", func() {
+				setValue("test-key", map[string]interface{}{"name": "test"})
+
+				_, err := service.DeleteKey(context.TODO(), &kvstorepb.KvStoreDeleteKeyRequest{
+					Ref: &kvstorepb.ValueRef{Store: "test-store", Key: "test-key"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.GetValue(context.TODO(), &kvstorepb.KvStoreGetValueRequest{
+					Ref: &kvstorepb.ValueRef{Store: "test-store", Key: "test-key"},
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("ScanKeys", func() {
+		When("scanning with a prefix", func() {
+			It("should stream matching keys in order", func() {
+				for _, key := range []string{"b", "a2", "a1", "c"} {
+					setValue(key, map[string]interface{}{"key": key})
+				}
+
+				stream := &scanKeysStream{}
+				err := service.ScanKeys(&kvstorepb.KvStoreScanKeysRequest{
+					Store:  &kvstorepb.Store{Name: "test-store"},
+					Prefix: "a",
+				}, stream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.keys).To(Equal([]string{"a1", "a2"}))
+			})
+		})
+
+		When("scanning a page at a time", func() {
+			scan := func(req *kvstorepb.KvStoreScanKeysRequest) ([]string, string) {
+				req.Store = &kvstorepb.Store{Name: "test-store"}
+				stream := &scanKeysStream{}
+				Expect(service.ScanKeys(req, stream)).To(Succeed())
+				return stream.keys, stream.continuationToken
+			}
+
+			BeforeEach(func() {
+				for _, key := range []string{"b", "a3", "a2", "a1", "c"} {
+					setValue(key, map[string]interface{}{"key": key})
+				}
+			})
+
+			It("should resume from the continuation token", func() {
+				keys, token := scan(&kvstorepb.KvStoreScanKeysRequest{Prefix: "a", Limit: 2})
+				Expect(keys).To(Equal([]string{"a1", "a2"}))
+				Expect(token).To(Equal("a2"))
+
+				keys, token = scan(&kvstorepb.KvStoreScanKeysRequest{Prefix: "a", Limit: 2, StartAfter: token})
+				Expect(keys).To(Equal([]string{"a3"}))
+				Expect(token).To(BeEmpty())
+			})
+
+			It("should not return a continuation token when the limit is exactly reached", func() {
+				keys, token := scan(&kvstorepb.KvStoreScanKeysRequest{Prefix: "a", Limit: 3})
+				Expect(keys).To(Equal([]string{"a1", "a2", "a3"}))
+				Expect(token).To(BeEmpty())
+			})
+
+			It("should scan in reverse", func() {
+				keys, token := scan(&kvstorepb.KvStoreScanKeysRequest{Prefix: "a", Limit: 2, Reverse: true})
+				Expect(keys).To(Equal([]string{"a3", "a2"}))
+				Expect(token).To(Equal("a2"))
+
+				keys, _ = scan(&kvstorepb.KvStoreScanKeysRequest{Prefix: "a", Reverse: true, StartAfter: token})
+				Expect(keys).To(Equal([]string{"a1"}))
+
+				keys, _ = scan(&kvstorepb.KvStoreScanKeysRequest{Reverse: true})
+				Expect(keys).To(Equal([]string{"c", "b", "a3", "a2", "a1"}))
+			})
+
+			It("should reject a negative limit", func() {
+				err := service.ScanKeys(&kvstorepb.KvStoreScanKeysRequest{
+					Store: &kvstorepb.Store{Name: "test-store"},
+					Limit: -1,
+				}, &scanKeysStream{})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("SetValue with a ttl", func() {
+		var now time.Time
+
+		setExpiringValue := func(key string, ttl time.Duration) error {
+			_, err := service.SetValue(context.TODO(), &kvstorepb.KvStoreSetValueRequest{
+				Ref:     &kvstorepb.ValueRef{Store: "test-store", Key: key},
+				Content: &structpb.Struct{},
+				Ttl:     durationpb.New(ttl),
+			})
+			return err
+		}
+
+		getValue := func(key string) error {
+			_, err := service.GetValue(context.TODO(), &kvstorepb.KvStoreGetValueRequest{
+				Ref: &kvstorepb.ValueRef{Store: "test-store", Key: key},
+			})
+			return err
+		}
+
+		BeforeEach(func() {
+			now = time.Now()
+			service.now = func() time.Time { return now }
+		})
+
+		When("the ttl has not elapsed", func() {
+			It("should return the value", func() {
+				Expect(setExpiringValue("session", time.Minute)).To(Succeed())
+
+				now = now.Add(59 * time.Second)
+				Expect(getValue("session")).To(Succeed())
+			})
+		})
+
+		When("the ttl has elapsed", func() {
+			It("should no longer return the value or its key", func() {
+				Expect(setExpiringValue("session", time.Minute)).To(Succeed())
+				setValue("other", map[string]interface{}{})
+
+				now = now.Add(time.Minute)
+				Expect(status.Code(getValue("session"))).To(Equal(codes.NotFound))
+
+				stream := &scanKeysStream{}
+				Expect(service.ScanKeys(&kvstorepb.KvStoreScanKeysRequest{
+					Store: &kvstorepb.Store{Name: "test-store"},
+				}, stream)).To(Succeed())
+				Expect(stream.keys).To(Equal([]string{"other"}))
+			})
+		})
+
+		When("the value is overwritten without a ttl", func() {
+			It("should no longer expire", func() {
+				Expect(setExpiringValue("session", time.Minute)).To(Succeed())
+				setValue("session", map[string]interface{}{})
+
+				now = now.Add(time.Hour)
+				Expect(getValue("session")).To(Succeed())
+			})
+		})
+
+		When("the ttl is negative", func() {
+			It("should return an invalid argument error", func() {
+				err := setExpiringValue("session", -time.Minute)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("conditional writes", func() {
+		ref := &kvstorepb.ValueRef{Store: "test-store", Key: "counter"}
+
+		currentVersion := func() string {
+			resp, err := service.GetValue(context.TODO(), &kvstorepb.KvStoreGetValueRequest{Ref: ref})
+			Expect(err).ShouldNot(HaveOccurred())
+			return resp.Value.Version
+		}
+
+		set := func(ifMatch string, ifNotExists bool) error {
+			_, err := service.SetValue(context.TODO(), &kvstorepb.KvStoreSetValueRequest{
+				Ref:         ref,
+				Content:     &structpb.Struct{},
+				IfMatch:     ifMatch,
+				IfNotExists: ifNotExists,
+			})
+			return err
+		}
+
+		When("setting with if_not_exists", func() {
+			It("should only succeed the first time", func() {
+				Expect(set("", true)).To(Succeed())
+				Expect(status.Code(set("", true))).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		When("setting with if_match", func() {
+			It("should only succeed with the current version", func() {
+				Expect(set("", false)).To(Succeed())
+				version := currentVersion()
+
+				Expect(set(version, false)).To(Succeed())
+				Expect(currentVersion()).ToNot(Equal(version))
+				Expect(status.Code(set(version, false))).To(Equal(codes.FailedPrecondition))
+			})
+
+			It("should fail if the key doesn't exist", func() {
+				Expect(status.Code(set("1", false))).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		When("setting with both conditions", func() {
+			It("should return an invalid argument error", func() {
+				Expect(status.Code(set("1", true))).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("deleting with if_match", func() {
+			It("should only succeed with the current version", func() {
+				Expect(set("", false)).To(Succeed())
+				version := currentVersion()
+				Expect(set("", false)).To(Succeed())
+
+				_, err := service.DeleteKey(context.TODO(), &kvstorepb.KvStoreDeleteKeyRequest{Ref: ref, IfMatch: version})
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+				_, err = service.DeleteKey(context.TODO(), &kvstorepb.KvStoreDeleteKeyRequest{Ref: ref, IfMatch: currentVersion()})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("batch operations", func() {
+		refs := []*kvstorepb.ValueRef{
+			{Store: "test-store", Key: "a"},
+			{Store: "test-store", Key: "b"},
+			{Store: "other-store", Key: "a"},
+		}
+
+		codesOf := func(results []*kvstorepb.KvStoreBatchResult) []codes.Code {
+			resultCodes := make([]codes.Code, 0, len(results))
+			for _, result := range results {
+				resultCodes = append(resultCodes, codes.Code(result.Code))
+			}
+			return resultCodes
+		}
+
+		When("setting values", func() {
+			It("should store every value", func() {
+				values := make([]*kvstorepb.Value, 0, len(refs))
+				for _, ref := range refs {
+					values = append(values, &kvstorepb.Value{Ref: ref, Content: &structpb.Struct{}})
+				}
+
+				resp, err := service.SetValues(context.TODO(), &kvstorepb.KvStoreSetValuesRequest{Values: values})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(resp.Results)).To(Equal([]codes.Code{codes.OK, codes.OK, codes.OK}))
+
+				getResp, err := service.GetValues(context.TODO(), &kvstorepb.KvStoreGetValuesRequest{Refs: refs})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(getResp.Results)).To(Equal([]codes.Code{codes.OK, codes.OK, codes.OK}))
+				Expect(getResp.Results[2].Value.Ref.Store).To(Equal("other-store"))
+			})
+
+			It("should report values without content individually", func() {
+				resp, err := service.SetValues(context.TODO(), &kvstorepb.KvStoreSetValuesRequest{
+					Values: []*kvstorepb.Value{
+						{Ref: refs[0], Content: &structpb.Struct{}},
+						{Ref: refs[1]},
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(resp.Results)).To(Equal([]codes.Code{codes.OK, codes.InvalidArgument}))
+			})
+		})
+
+		When("getting values", func() {
+			It("should report missing keys individually", func() {
+				setValue("a", map[string]interface{}{"name": "test"})
+
+				resp, err := service.GetValues(context.TODO(), &kvstorepb.KvStoreGetValuesRequest{Refs: refs[:2]})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(resp.Results)).To(Equal([]codes.Code{codes.OK, codes.NotFound}))
+				Expect(resp.Results[0].Value.Content.AsMap()).To(Equal(map[string]interface{}{"name": "test"}))
+				Expect(resp.Results[1].Ref.Key).To(Equal("b"))
+			})
+
+			It("should reject duplicate keys", func() {
+				_, err := service.GetValues(context.TODO(), &kvstorepb.KvStoreGetValuesRequest{Refs: []*kvstorepb.ValueRef{refs[0], refs[0]}})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("deleting keys", func() {
+			It("should remove every key", func() {
+				setValue("a", map[string]interface{}{})
+				setValue("b", map[string]interface{}{})
+
+				resp, err := service.DeleteKeys(context.TODO(), &kvstorepb.KvStoreDeleteKeysRequest{Refs: refs})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(resp.Results)).To(Equal([]codes.Code{codes.OK, codes.OK, codes.OK}))
+
+				getResp, err := service.GetValues(context.TODO(), &kvstorepb.KvStoreGetValuesRequest{Refs: refs})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(codesOf(getResp.Results)).To(Equal([]codes.Code{codes.NotFound, codes.NotFound, codes.NotFound}))
+			})
+		})
+	})
+})
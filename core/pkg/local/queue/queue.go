@@ -0,0 +1,276 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators/queue"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
+)
+
+// maxDelay is the longest a message can be delayed before it's available, matching the limit of SQS
+const maxDelay = 15 * time.Minute
+
+// maxLeaseDuration is the longest a message can be leased for, matching the limit of SQS
+const maxLeaseDuration = 12 * time.Hour
+
+// maxWait is the longest a dequeue can wait for messages to become available, matching the limit of SQS long polling
+const maxWait = 20 * time.Second
+
+// pollInterval is how often an empty queue is checked again while a dequeue is waiting
+const pollInterval = 100 * time.Millisecond
+
+type queuedMessage struct {
+	message *queuespb.QueueMessage
+	// the time a delayed or released message becomes available, zero if it was available immediately
+	visibleAt time.Time
+	// the current lease on the message, empty if the message isn't leased
+	leaseId     string
+	leaseExpiry time.Time
+	// the number of times the message has been leased
+	receiveCount int32
+}
+
+// available returns true if the message isn't delayed or currently leased by a consumer
+func (m *queuedMessage) available(now time.Time) bool {
+	return !now.Before(m.visibleAt) && (m.leaseId == "" || now.After(m.leaseExpiry))
+}
+
+// LocalQueueService - an in memory implementation of the Nitric Queues Service
+// Dequeued messages are leased, becoming visible again if they aren't completed before the lease expires.
+type LocalQueueService struct {
+	queues        map[string][]*queuedMessage
+	leaseDuration time.Duration
+	lock          sync.Mutex
+}
+
+var _ queuespb.QueuesServer = (*LocalQueueService)(nil)
+
+// Enqueue messages on a queue
+func (s *LocalQueueService) Enqueue(ctx context.Context, req *queuespb.QueueEnqueueRequest) (*queuespb.QueueEnqueueResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalQueueService.Enqueue")
+
+	if req.QueueName == "" {
+		return nil, newErr(codes.InvalidArgument, "queue name cannot be empty", nil)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	failed := []*queuespb.FailedEnqueueMessage{}
+	for _, message := range req.Messages {
+		if message.GetContent() == nil {
+			failed = append(failed, &queuespb.FailedEnqueueMessage{
+				Message: message,
+				Details: "message content cannot be empty",
+			})
+			continue
+		}
+
+		if err := queue.ValidateDelay(message.Delay, maxDelay); err != nil {
+			failed = append(failed, &queuespb.FailedEnqueueMessage{
+				Message: message,
+				Details: err.Error(),
+			})
+			continue
+		}
+
+		s.queues[req.QueueName] = append(s.queues[req.QueueName], &queuedMessage{
+			// only the content and its type are stored, the delay only applies to enqueuing
+			message:   &queuespb.QueueMessage{Content: message.Content, ContentType: message.ContentType},
+			visibleAt: time.Now().Add(message.Delay.AsDuration()),
+		})
+	}
+
+	return &queuespb.QueueEnqueueResponse{
+		FailedMessages: failed,
+	}, nil
+}
+
+// Dequeue up to depth messages from a queue, leasing them to the caller
+func (s *LocalQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeueRequest) (*queuespb.QueueDequeueResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalQueueService.Dequeue")
+
+	if req.QueueName == "" {
+		return nil, newErr(codes.InvalidArgument, "queue name cannot be empty", nil)
+	}
+
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxLeaseDuration); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid lease duration", err)
+	}
+
+	if err := queue.ValidateWait(req.Wait, maxWait); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid wait time", err)
+	}
+
+	leaseDuration := s.leaseDuration
+	if req.LeaseDuration != nil {
+		leaseDuration = req.LeaseDuration.AsDuration()
+	}
+
+	depth := int(req.Depth)
+	if depth < 1 {
+		depth = 1
+	}
+
+	// messages can become available through enqueuing, delays elapsing or leases expiring, so the queue is polled while waiting
+	messages, _ := queue.Poll(ctx, req.Wait.AsDuration(), pollInterval, func() ([]*queuespb.DequeuedMessage, error) {
+		return s.lease(req.QueueName, depth, leaseDuration), nil
+	})
+
+	return &queuespb.QueueDequeueResponse{
+		Messages: messages,
+	}, nil
+}
+
+// lease up to depth available messages from a queue for leaseDuration
+func (s *LocalQueueService) lease(queueName string, depth int, leaseDuration time.Duration) []*queuespb.DequeuedMessage {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	messages := make([]*queuespb.DequeuedMessage, 0, depth)
+
+	for _, m := range s.queues[queueName] {
+		if len(messages) >= depth {
+			break
+		}
+
+		if !m.available(now) {
+			continue
+		}
+
+		m.leaseId = uuid.New().String()
+		m.leaseExpiry = now.Add(leaseDuration)
+		m.receiveCount++
+
+		messages = append(messages, &queuespb.DequeuedMessage{
+			LeaseId:      m.leaseId,
+			Message:      m.message,
+			ReceiveCount: m.receiveCount,
+		})
+	}
+
+	return messages
+}
+
+// Complete a previously dequeued message, removing it from the queue
+func (s *LocalQueueService) Complete(ctx context.Context, req *queuespb.QueueCompleteRequest) (*queuespb.QueueCompleteResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalQueueService.Complete")
+
+	if req.LeaseId == "" {
+		return nil, newErr(codes.InvalidArgument, "lease id cannot be empty", nil)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	messages := s.queues[req.QueueName]
+	idx := s.leasedIndex(req.QueueName, req.LeaseId)
+
+	if idx < 0 {
+		return nil, newErr(codes.NotFound, fmt.Sprintf("no message with lease %s on queue %s", req.LeaseId, req.QueueName), nil)
+	}
+
+	s.queues[req.QueueName] = slices.Delete(messages, idx, idx+1)
+
+	return &queuespb.QueueCompleteResponse{}, nil
+}
+
+// ExtendLease on a previously dequeued message, so it remains leased for the requested duration from now
+func (s *LocalQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalQueueService.ExtendLease")
+
+	if req.LeaseId == "" {
+		return nil, newErr(codes.InvalidArgument, "lease id cannot be empty", nil)
+	}
+
+	if req.LeaseDuration == nil {
+		return nil, newErr(codes.InvalidArgument, "lease duration is required", nil)
+	}
+
+	if err := queue.ValidateLeaseDuration(req.LeaseDuration, maxLeaseDuration); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid lease duration", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	idx := s.leasedIndex(req.QueueName, req.LeaseId)
+	if idx < 0 {
+		return nil, newErr(codes.NotFound, fmt.Sprintf("no message with lease %s on queue %s", req.LeaseId, req.QueueName), nil)
+	}
+
+	s.queues[req.QueueName][idx].leaseExpiry = time.Now().Add(req.LeaseDuration.AsDuration())
+
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: req.LeaseId,
+	}, nil
+}
+
+// Release a previously dequeued message without completing it, making it available again after the requested delay
+func (s *LocalQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalQueueService.Release")
+
+	if req.LeaseId == "" {
+		return nil, newErr(codes.InvalidArgument, "lease id cannot be empty", nil)
+	}
+
+	if err := queue.ValidateDelay(req.Delay, maxDelay); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid delay", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	idx := s.leasedIndex(req.QueueName, req.LeaseId)
+	if idx < 0 {
+		return nil, newErr(codes.NotFound, fmt.Sprintf("no message with lease %s on queue %s", req.LeaseId, req.QueueName), nil)
+	}
+
+	m := s.queues[req.QueueName][idx]
+	m.leaseId = ""
+	m.visibleAt = time.Now().Add(req.Delay.AsDuration())
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// leasedIndex returns the index of the message with the given lease on a queue, or -1 if there isn't one
+func (s *LocalQueueService) leasedIndex(queueName string, leaseId string) int {
+	return slices.IndexFunc(s.queues[queueName], func(m *queuedMessage) bool {
+		return m.leaseId == leaseId
+	})
+}
+
+// New creates a new in memory queues plugin, leasing dequeued messages for leaseDuration
+func New(leaseDuration time.Duration) (*LocalQueueService, error) {
+	if leaseDuration <= 0 {
+		return nil, fmt.Errorf("queue lease duration must be positive, got %s", leaseDuration)
+	}
+
+	return &LocalQueueService{
+		queues:        map[string][]*queuedMessage{},
+		leaseDuration: leaseDuration,
+	}, nil
+}
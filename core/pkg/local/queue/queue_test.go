@@ -0,0 +1,369 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
+)
+
+func testMessage() *queuespb.QueueMessage {
+	payload, _ := structpb.NewStruct(map[string]interface{}{
+		"test": "message",
+	})
+
+	return &queuespb.QueueMessage{
+		Content: &queuespb.QueueMessage_StructPayload{
+			StructPayload: payload,
+		},
+	}
+}
+
+var _ = Describe("LocalQueueService", func() {
+	var service *LocalQueueService
+
+	BeforeEach(func() {
+		var err error
+		service, err = New(50 * time.Millisecond)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("New", func() {
+		When("the lease duration is not positive", func() {
+			It("should return an error", func() {
+				_, err := New(0)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Enqueue", func() {
+		When("a message has no content", func() {
+			It("should be returned as a failed message", func() {
+				resp, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+					QueueName: "test-queue",
+					Messages:  []*queuespb.QueueMessage{testMessage(), {}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(HaveLen(1))
+			})
+		})
+
+		When("a message has a bytes payload", func() {
+			It("should be dequeued with its content type", func() {
+				_, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+					QueueName: "bytes-queue",
+					Messages: []*queuespb.QueueMessage{{
+						Content: &queuespb.QueueMessage_BytesPayload{
+							BytesPayload: []byte{0x0a, 0x04, 't', 'e', 's', 't'},
+						},
+						ContentType: "application/x-protobuf",
+					}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "bytes-queue",
+					Depth:     1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(1))
+				Expect(resp.Messages[0].Message.GetBytesPayload()).To(Equal([]byte{0x0a, 0x04, 't', 'e', 's', 't'}))
+				Expect(resp.Messages[0].Message.ContentType).To(Equal("application/x-protobuf"))
+			})
+		})
+	})
+
+	Context("Dequeue", func() {
+		BeforeEach(func() {
+			_, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+				QueueName: "test-queue",
+				Messages:  []*queuespb.QueueMessage{testMessage(), testMessage()},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		When("dequeuing up to depth messages", func() {
+			It("should lease at most depth messages", func() {
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(1))
+				Expect(resp.Messages[0].LeaseId).ToNot(BeEmpty())
+			})
+		})
+
+		When("messages are already leased", func() {
+			It("should not return them again until the lease expires", func() {
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     10,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(2))
+
+				resp, err = service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     10,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(BeEmpty())
+
+				time.Sleep(60 * time.Millisecond)
+
+				resp, err = service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     10,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(2))
+			})
+
+			It("should count the number of times each message has been received", func() {
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages[0].ReceiveCount).To(Equal(int32(1)))
+
+				time.Sleep(60 * time.Millisecond)
+
+				resp, err = service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages[0].ReceiveCount).To(Equal(int32(2)))
+			})
+		})
+
+		When("waiting for leased messages to become available", func() {
+			It("should return them once the lease expires", func() {
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     10,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(2))
+
+				resp, err = service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     10,
+					Wait:      durationpb.New(time.Second),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(HaveLen(2))
+			})
+		})
+
+		When("waiting on an empty queue", func() {
+			It("should return no messages after the wait time", func() {
+				start := time.Now()
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "empty-queue",
+					Depth:     10,
+					Wait:      durationpb.New(150 * time.Millisecond),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Messages).To(BeEmpty())
+				Expect(time.Since(start)).To(BeNumerically(">=", 150*time.Millisecond))
+			})
+		})
+
+		When("the wait time is over the maximum", func() {
+			It("should return an invalid argument error", func() {
+				_, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Wait:      durationpb.New(time.Minute),
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Complete", func() {
+		When("completing a leased message", func() {
+			It("should remove the message from the queue", func() {
+				_, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+					QueueName: "test-queue",
+					Messages:  []*queuespb.QueueMessage{testMessage()},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+					QueueName: "test-queue",
+					Depth:     1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.Complete(context.TODO(), &queuespb.QueueCompleteRequest{
+					QueueName: "test-queue",
+					LeaseId:   resp.Messages[0].LeaseId,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(service.queues["test-queue"]).To(BeEmpty())
+			})
+		})
+
+		When("the lease does not exist", func() {
+			It("should return a not found error", func() {
+				_, err := service.Complete(context.TODO(), &queuespb.QueueCompleteRequest{
+					QueueName: "test-queue",
+					LeaseId:   "missing-lease",
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("Delayed messages", func() {
+		When("a message is enqueued with a delay", func() {
+			It("should not be dequeued until the delay has passed", func() {
+				message := testMessage()
+				message.Delay = durationpb.New(50 * time.Millisecond)
+
+				resp, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+					QueueName: "test-queue",
+					Messages:  []*queuespb.QueueMessage{message},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(BeEmpty())
+
+				dequeued, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{QueueName: "test-queue"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(dequeued.Messages).To(BeEmpty())
+
+				time.Sleep(60 * time.Millisecond)
+
+				dequeued, err = service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{QueueName: "test-queue"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(dequeued.Messages).To(HaveLen(1))
+				Expect(dequeued.Messages[0].Message.Delay).To(BeNil())
+			})
+		})
+
+		When("the delay is too long", func() {
+			It("should be returned as a failed message", func() {
+				message := testMessage()
+				message.Delay = durationpb.New(time.Hour)
+
+				resp, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+					QueueName: "test-queue",
+					Messages:  []*queuespb.QueueMessage{message},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(HaveLen(1))
+			})
+		})
+	})
+
+	Context("Leases", func() {
+		var leaseId string
+
+		dequeue := func(leaseDuration time.Duration) []*queuespb.DequeuedMessage {
+			resp, err := service.Dequeue(context.TODO(), &queuespb.QueueDequeueRequest{
+				QueueName:     "test-queue",
+				LeaseDuration: durationpb.New(leaseDuration),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			return resp.Messages
+		}
+
+		BeforeEach(func() {
+			_, err := service.Enqueue(context.TODO(), &queuespb.QueueEnqueueRequest{
+				QueueName: "test-queue",
+				Messages:  []*queuespb.QueueMessage{testMessage()},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			messages := dequeue(20 * time.Millisecond)
+			Expect(messages).To(HaveLen(1))
+			leaseId = messages[0].LeaseId
+		})
+
+		When("dequeuing with a lease duration", func() {
+			It("should lease the message for that duration", func() {
+				time.Sleep(30 * time.Millisecond)
+				Expect(dequeue(time.Minute)).To(HaveLen(1))
+			})
+		})
+
+		When("extending a lease", func() {
+			It("should keep the message leased", func() {
+				resp, err := service.ExtendLease(context.TODO(), &queuespb.QueueExtendLeaseRequest{
+					QueueName:     "test-queue",
+					LeaseId:       leaseId,
+					LeaseDuration: durationpb.New(time.Minute),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.LeaseId).To(Equal(leaseId))
+
+				time.Sleep(30 * time.Millisecond)
+				Expect(dequeue(time.Minute)).To(BeEmpty())
+			})
+
+			It("should require a lease duration", func() {
+				_, err := service.ExtendLease(context.TODO(), &queuespb.QueueExtendLeaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseId,
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("releasing a lease", func() {
+			It("should make the message available immediately", func() {
+				_, err := service.Release(context.TODO(), &queuespb.QueueReleaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseId,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(dequeue(time.Minute)).To(HaveLen(1))
+
+				_, err = service.Complete(context.TODO(), &queuespb.QueueCompleteRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseId,
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+
+			It("should make the message available after the delay", func() {
+				_, err := service.Release(context.TODO(), &queuespb.QueueReleaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseId,
+					Delay:     durationpb.New(time.Minute),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				time.Sleep(30 * time.Millisecond)
+				Expect(dequeue(time.Minute)).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,188 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/schedules"
+)
+
+// LocalScheduleManager - a schedule worker manager that also triggers its registered schedules in process
+// Schedules are triggered on their registered cadence for as long as their worker remains connected.
+type LocalScheduleManager struct {
+	*schedules.ScheduleWorkerManager
+	cron    *cron.Cron
+	entries map[string]*scheduleEntry
+	lock    sync.Mutex
+}
+
+// scheduleEntry is the cron entry for a schedule, shared by all of the workers registered for it
+type scheduleEntry struct {
+	id      cron.EntryID
+	workers int
+}
+
+var _ schedules.ScheduleRequestHandler = (*LocalScheduleManager)(nil)
+
+// registrationStream captures the registration request of a schedule worker, so the schedule can be started once it's acknowledged
+type registrationStream struct {
+	schedulespb.Schedules_ScheduleServer
+	registration *schedulespb.RegistrationRequest
+	onRegistered func(*schedulespb.RegistrationRequest)
+}
+
+func (r *registrationStream) Recv() (*schedulespb.ClientMessage, error) {
+	msg, err := r.Schedules_ScheduleServer.Recv()
+	if err == nil && r.registration == nil && msg.GetRegistrationRequest() != nil {
+		r.registration = msg.GetRegistrationRequest()
+	}
+
+	return msg, err
+}
+
+func (r *registrationStream) Send(msg *schedulespb.ServerMessage) error {
+	err := r.Schedules_ScheduleServer.Send(msg)
+	if err == nil && msg.GetRegistrationResponse() != nil && r.registration != nil {
+		r.onRegistered(r.registration)
+	}
+
+	return err
+}
+
+// CronExpression converts the cadence of a schedule registration to an expression supported by the scheduler
+func CronExpression(registration *schedulespb.RegistrationRequest) (string, error) {
+	switch registration.Cadence.(type) {
+	case *schedulespb.RegistrationRequest_Cron:
+		return registration.GetCron().Expression, nil
+	case *schedulespb.RegistrationRequest_Every:
+		parts := strings.Split(strings.TrimSpace(registration.GetEvery().Rate), " ")
+		if len(parts) != 2 {
+			return "", fmt.Errorf("invalid schedule rate: %s", registration.GetEvery().Rate)
+		}
+
+		rate, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return "", fmt.Errorf("invalid schedule rate, must start with an integer")
+		}
+
+		unit := strings.TrimSuffix(parts[1], "s")
+		switch unit {
+		case "minute":
+			return fmt.Sprintf("@every %dm", rate), nil
+		case "hour":
+			return fmt.Sprintf("@every %dh", rate), nil
+		case "day":
+			return fmt.Sprintf("@every %dh", rate*24), nil
+		default:
+			return "", fmt.Errorf("invalid schedule rate unit %s, must be one of: minutes, hours, days", parts[1])
+		}
+	default:
+		return "", fmt.Errorf("unknown schedule type, must be one of: cron, every")
+	}
+}
+
+func (s *LocalScheduleManager) trigger(scheduleName string) {
+	_, err := s.HandleRequest(context.Background(), &schedulespb.ServerMessage{
+		Content: &schedulespb.ServerMessage_IntervalRequest{
+			IntervalRequest: &schedulespb.IntervalRequest{
+				ScheduleName: scheduleName,
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorf("could not handle trigger for schedule %s: %v", scheduleName, err)
+	}
+}
+
+// Schedule registers a schedule worker and triggers it on its cadence until the worker disconnects
+func (s *LocalScheduleManager) Schedule(stream schedulespb.Schedules_ScheduleServer) error {
+	var scheduleName string
+
+	regStream := &registrationStream{
+		Schedules_ScheduleServer: stream,
+		onRegistered: func(registration *schedulespb.RegistrationRequest) {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+
+			// additional workers for a schedule share the existing trigger
+			if entry, ok := s.entries[registration.ScheduleName]; ok {
+				entry.workers++
+				scheduleName = registration.ScheduleName
+				return
+			}
+
+			expression, err := CronExpression(registration)
+			if err != nil {
+				logger.Errorf("schedule %s will not be triggered: %v", registration.ScheduleName, err)
+				return
+			}
+
+			entryId, err := s.cron.AddFunc(expression, func() {
+				s.trigger(registration.ScheduleName)
+			})
+			if err != nil {
+				logger.Errorf("schedule %s will not be triggered, invalid expression %s: %v", registration.ScheduleName, expression, err)
+				return
+			}
+
+			s.entries[registration.ScheduleName] = &scheduleEntry{id: entryId, workers: 1}
+			scheduleName = registration.ScheduleName
+		},
+	}
+
+	defer func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		entry, ok := s.entries[scheduleName]
+		if !ok {
+			return
+		}
+
+		entry.workers--
+		if entry.workers == 0 {
+			s.cron.Remove(entry.id)
+			delete(s.entries, scheduleName)
+		}
+	}()
+
+	return s.ScheduleWorkerManager.Schedule(regStream)
+}
+
+// Stop triggering schedules
+func (s *LocalScheduleManager) Stop() {
+	s.cron.Stop()
+}
+
+// New creates a new local schedule manager and starts its scheduler
+func New() *LocalScheduleManager {
+	c := cron.New()
+	c.Start()
+
+	return &LocalScheduleManager{
+		ScheduleWorkerManager: schedules.New(),
+		cron:                  c,
+		entries:               map[string]*scheduleEntry{},
+	}
+}
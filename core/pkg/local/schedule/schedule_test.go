@@ -0,0 +1,77 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+)
+
+func everyRegistration(rate string) *schedulespb.RegistrationRequest {
+	return &schedulespb.RegistrationRequest{
+		ScheduleName: "test-schedule",
+		Cadence: &schedulespb.RegistrationRequest_Every{
+			Every: &schedulespb.ScheduleEvery{
+				Rate: rate,
+			},
+		},
+	}
+}
+
+var _ = Describe("CronExpression", func() {
+	When("the registration uses a rate", func() {
+		It("should convert minutes to an interval", func() {
+			expression, err := CronExpression(everyRegistration("5 minutes"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expression).To(Equal("@every 5m"))
+		})
+
+		It("should convert a single hour to an interval", func() {
+			expression, err := CronExpression(everyRegistration("1 hour"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expression).To(Equal("@every 1h"))
+		})
+
+		It("should convert days to an interval in hours", func() {
+			expression, err := CronExpression(everyRegistration("2 days"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expression).To(Equal("@every 48h"))
+		})
+	})
+
+	When("the rate unit is invalid", func() {
+		It("should return an error", func() {
+			_, err := CronExpression(everyRegistration("5 weeks"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("the registration uses a cron expression", func() {
+		It("should return the expression unchanged", func() {
+			expression, err := CronExpression(&schedulespb.RegistrationRequest{
+				ScheduleName: "test-schedule",
+				Cadence: &schedulespb.RegistrationRequest_Cron{
+					Cron: &schedulespb.ScheduleCron{
+						Expression: "0 * * * *",
+					},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expression).To(Equal("0 * * * *"))
+		})
+	})
+})
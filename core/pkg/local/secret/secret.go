@@ -0,0 +1,108 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	secretspb "github.com/nitrictech/nitric/core/pkg/proto/secrets/v1"
+)
+
+const latestVersion = "latest"
+
+// LocalSecretService - an in memory implementation of the Nitric Secret Manager Service
+// Versions are numbered sequentially from 1, and the "latest" version resolves to the most recent put.
+type LocalSecretService struct {
+	secrets map[string][][]byte
+	lock    sync.RWMutex
+}
+
+var _ secretspb.SecretManagerServer = (*LocalSecretService)(nil)
+
+// Put - Store a new secret value
+func (s *LocalSecretService) Put(ctx context.Context, req *secretspb.SecretPutRequest) (*secretspb.SecretPutResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalSecretService.Put")
+
+	if req.Secret.GetName() == "" {
+		return nil, newErr(codes.InvalidArgument, "secret name cannot be empty", nil)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	value := make([]byte, len(req.Value))
+	copy(value, req.Value)
+
+	s.secrets[req.Secret.Name] = append(s.secrets[req.Secret.Name], value)
+
+	return &secretspb.SecretPutResponse{
+		SecretVersion: &secretspb.SecretVersion{
+			Secret: &secretspb.Secret{
+				Name: req.Secret.Name,
+			},
+			Version: strconv.Itoa(len(s.secrets[req.Secret.Name])),
+		},
+	}, nil
+}
+
+// Access - Retrieve a secret value
+func (s *LocalSecretService) Access(ctx context.Context, req *secretspb.SecretAccessRequest) (*secretspb.SecretAccessResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalSecretService.Access")
+
+	name := req.SecretVersion.GetSecret().GetName()
+	if name == "" {
+		return nil, newErr(codes.InvalidArgument, "secret name cannot be blank or empty", nil)
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	versions, ok := s.secrets[name]
+	if !ok || len(versions) == 0 {
+		return nil, newErr(codes.NotFound, fmt.Sprintf("secret %s has no versions", name), nil)
+	}
+
+	version := len(versions)
+	if req.SecretVersion.Version != latestVersion {
+		v, err := strconv.Atoi(req.SecretVersion.Version)
+		if err != nil || v < 1 || v > len(versions) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("version %s of secret %s not found", req.SecretVersion.Version, name), err)
+		}
+		version = v
+	}
+
+	return &secretspb.SecretAccessResponse{
+		SecretVersion: &secretspb.SecretVersion{
+			Secret: &secretspb.Secret{
+				Name: name,
+			},
+			Version: strconv.Itoa(version),
+		},
+		Value: versions[version-1],
+	}, nil
+}
+
+// New creates a new in memory secrets plugin
+func New() (*LocalSecretService, error) {
+	return &LocalSecretService{
+		secrets: map[string][][]byte{},
+	}, nil
+}
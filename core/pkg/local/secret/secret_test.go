@@ -0,0 +1,92 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	secretspb "github.com/nitrictech/nitric/core/pkg/proto/secrets/v1"
+)
+
+var _ = Describe("LocalSecretService", func() {
+	var service *LocalSecretService
+
+	BeforeEach(func() {
+		service, _ = New()
+	})
+
+	When("putting secret versions", func() {
+		It("should number versions sequentially", func() {
+			for i, value := range []string{"one", "two"} {
+				resp, err := service.Put(context.TODO(), &secretspb.SecretPutRequest{
+					Secret: &secretspb.Secret{Name: "test-secret"},
+					Value:  []byte(value),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.SecretVersion.Version).To(Equal([]string{"1", "2"}[i]))
+			}
+		})
+	})
+
+	When("accessing secret versions", func() {
+		BeforeEach(func() {
+			for _, value := range []string{"one", "two"} {
+				_, err := service.Put(context.TODO(), &secretspb.SecretPutRequest{
+					Secret: &secretspb.Secret{Name: "test-secret"},
+					Value:  []byte(value),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("should resolve latest to the most recent version", func() {
+			resp, err := service.Access(context.TODO(), &secretspb.SecretAccessRequest{
+				SecretVersion: &secretspb.SecretVersion{
+					Secret:  &secretspb.Secret{Name: "test-secret"},
+					Version: "latest",
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.SecretVersion.Version).To(Equal("2"))
+			Expect(resp.Value).To(Equal([]byte("two")))
+		})
+
+		It("should return a specific version", func() {
+			resp, err := service.Access(context.TODO(), &secretspb.SecretAccessRequest{
+				SecretVersion: &secretspb.SecretVersion{
+					Secret:  &secretspb.Secret{Name: "test-secret"},
+					Version: "1",
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Value).To(Equal([]byte("one")))
+		})
+
+		It("should return not found for a missing version", func() {
+			_, err := service.Access(context.TODO(), &secretspb.SecretAccessRequest{
+				SecretVersion: &secretspb.SecretVersion{
+					Secret:  &secretspb.Secret{Name: "test-secret"},
+					Version: "3",
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
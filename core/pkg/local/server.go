@@ -0,0 +1,111 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/local/api"
+	"github.com/nitrictech/nitric/core/pkg/local/env"
+	local_gateway "github.com/nitrictech/nitric/core/pkg/local/gateway"
+	"github.com/nitrictech/nitric/core/pkg/local/keyvalue"
+	"github.com/nitrictech/nitric/core/pkg/local/queue"
+	"github.com/nitrictech/nitric/core/pkg/local/schedule"
+	"github.com/nitrictech/nitric/core/pkg/local/secret"
+	local_storage "github.com/nitrictech/nitric/core/pkg/local/storage"
+	"github.com/nitrictech/nitric/core/pkg/local/topic"
+	"github.com/nitrictech/nitric/core/pkg/local/websocket"
+	"github.com/nitrictech/nitric/core/pkg/server"
+	"github.com/nitrictech/nitric/core/pkg/workers/storage"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+	"github.com/nitrictech/nitric/core/pkg/workers/websockets"
+)
+
+// gatewayUrl returns the URL clients can use to reach a gateway listening on address
+func gatewayUrl(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Sprintf("http://%s", address)
+	}
+
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, port))
+}
+
+// NewLocalRuntimeServer creates a nitric server backed by the local filesystem and in memory services,
+// allowing nitric applications to be run and tested without cloud credentials.
+func NewLocalRuntimeServer(opts ...server.ServerOption) (*server.NitricServer, error) {
+	dataDir := env.NITRIC_LOCAL_DATA_DIR.String()
+	gatewayAddress := env.GATEWAY_ADDRESS.String()
+
+	leaseSeconds, err := env.NITRIC_LOCAL_QUEUE_LEASE_SECONDS.Int()
+	if err != nil {
+		return nil, fmt.Errorf("invalid NITRIC_LOCAL_QUEUE_LEASE_SECONDS: %w", err)
+	}
+
+	// listeners are created up front so the local resource plugins can deliver events to them in process
+	topicsListenerPlugin := topics.New()
+	storageListenerPlugin := storage.New()
+	websocketListenerPlugin := websockets.NewWebsocketManager()
+	schedulesPlugin := schedule.New()
+
+	storagePlugin, err := local_storage.New(filepath.Join(dataDir, "buckets"), gatewayUrl(gatewayAddress), storageListenerPlugin)
+	if err != nil {
+		return nil, err
+	}
+
+	keyValuePlugin, err := keyvalue.New(filepath.Join(dataDir, "kv.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	queuesPlugin, err := queue.New(time.Duration(leaseSeconds) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	topicsPlugin, _ := topic.New(topicsListenerPlugin)
+	secretPlugin, _ := secret.New()
+	websocketPlugin, _ := websocket.New(gatewayUrl(gatewayAddress))
+	apiPlugin := api.New(gatewayUrl(gatewayAddress))
+
+	gatewayPlugin := local_gateway.New(gatewayAddress, storagePlugin, websocketPlugin)
+
+	defaultLocalOpts := []server.ServerOption{
+		server.WithGatewayPlugin(gatewayPlugin),
+		server.WithStoragePlugin(storagePlugin),
+		server.WithKeyValuePlugin(keyValuePlugin),
+		server.WithQueuesPlugin(queuesPlugin),
+		server.WithTopicsPlugin(topicsPlugin),
+		server.WithSecretManagerPlugin(secretPlugin),
+		server.WithWebsocketPlugin(websocketPlugin),
+		server.WithTopicsListenerPlugin(topicsListenerPlugin),
+		server.WithStorageListenerPlugin(storageListenerPlugin),
+		server.WithWebsocketListenerPlugin(websocketListenerPlugin),
+		server.WithSchedulesPlugin(schedulesPlugin),
+		server.WithApiPlugin(apiPlugin),
+	}
+
+	// append overrides
+	defaultLocalOpts = append(defaultLocalOpts, opts...)
+
+	return server.New(defaultLocalOpts...)
+}
@@ -0,0 +1,686 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/storage"
+)
+
+// PreSignRoute - the gateway route that serves pre-signed blob requests
+const PreSignRoute = "/x-nitric-storage"
+
+// LocalStorageService - a filesystem implementation of the Nitric Storage Service
+// Each bucket is a directory under the root directory and each blob a file in that directory.
+type LocalStorageService struct {
+	rootDir    string
+	gatewayUrl string
+	listener   storage.BucketRequestHandler
+}
+
+var _ storagepb.StorageServer = (*LocalStorageService)(nil)
+
+func (s *LocalStorageService) bucketPath(bucket string) (string, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || bucket == "." || bucket == ".." {
+		return "", fmt.Errorf("invalid bucket name %q", bucket)
+	}
+
+	return filepath.Join(s.rootDir, bucket), nil
+}
+
+// blobPath returns the file path of a blob, ensuring the key can't escape its bucket directory
+func (s *LocalStorageService) blobPath(bucket string, key string) (string, error) {
+	bucketPath, err := s.bucketPath(bucket)
+	if err != nil {
+		return "", err
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("blob key cannot be empty")
+	}
+
+	blobPath := filepath.Join(bucketPath, filepath.FromSlash(key))
+	if !strings.HasPrefix(blobPath, bucketPath+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return blobPath, nil
+}
+
+// notify forwards a blob event to any listeners registered for the bucket,
+// including the attributes of the blob at blobPath if it still exists
+func (s *LocalStorageService) notify(bucket string, key string, blobPath string, eventType storagepb.BlobEventType) {
+	if s.listener == nil || s.listener.WorkerCount() == 0 {
+		return
+	}
+
+	event := &storagepb.BlobEvent{
+		Key:  key,
+		Type: eventType,
+	}
+
+	if blob, err := s.blob(key, blobPath); err == nil {
+		event.Size = blob.Size
+		event.Etag = blob.Etag
+		event.ContentType = blob.ContentType
+	}
+
+	go func() {
+		_, err := s.listener.HandleRequest(context.Background(), &storagepb.ServerMessage{
+			Content: &storagepb.ServerMessage_BlobEventRequest{
+				BlobEventRequest: &storagepb.BlobEventRequest{
+					BucketName: bucket,
+					Event: &storagepb.BlobEventRequest_BlobEvent{
+						BlobEvent: event,
+					},
+				},
+			},
+		})
+		if err != nil {
+			logger.Debugf("blob event %s for %s/%s not handled: %v", eventType, bucket, key, err)
+		}
+	}()
+}
+
+// Read and return the contents of a file in a bucket
+func (s *LocalStorageService) Read(ctx context.Context, req *storagepb.StorageReadRequest) (*storagepb.StorageReadResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Read")
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	body, err := os.ReadFile(blobPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("blob %s not found in bucket %s", req.Key, req.BucketName), err)
+		}
+
+		return nil, newErr(codes.Unknown, "error reading file", err)
+	}
+
+	return &storagepb.StorageReadResponse{
+		Body: body,
+	}, nil
+}
+
+// blobAttributes are the attributes of a blob, stored in a file alongside it
+type blobAttributes struct {
+	ContentType  string            `json:"contentType"`
+	CacheControl string            `json:"cacheControl,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+// attributesPath returns the path of the file the attributes of a blob are stored in.
+// The .nitric- prefix hides the file from blob listings.
+func attributesPath(blobPath string) string {
+	return filepath.Join(filepath.Dir(blobPath), ".nitric-attrs-"+filepath.Base(blobPath)+".json")
+}
+
+// detectContentType detects the content type of a blob from its key, or the start of its contents when the key has no known extension.
+// Returns the content type and a reader that still includes the inspected contents.
+func detectContentType(key string, r io.Reader) (string, io.Reader) {
+	if contentType := mime.TypeByExtension(filepath.Ext(key)); contentType != "" {
+		return contentType, r
+	}
+
+	// http.DetectContentType considers at most the first 512 bytes
+	buffered := bufio.NewReaderSize(r, 512)
+	head, _ := buffered.Peek(512)
+
+	return http.DetectContentType(head), buffered
+}
+
+// blob returns the attributes of the blob stored at blobPath
+func (s *LocalStorageService) blob(key string, blobPath string) (*storagepb.Blob, error) {
+	info, err := os.Stat(blobPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return nil, fs.ErrNotExist
+	}
+
+	attrs := blobAttributes{}
+	if contents, err := os.ReadFile(attributesPath(blobPath)); err == nil {
+		if err := json.Unmarshal(contents, &attrs); err != nil {
+			return nil, fmt.Errorf("error reading blob attributes: %w", err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	// blobs written before their attributes were stored only have a detectable content type
+	if attrs.ContentType == "" {
+		attrs.ContentType = mime.TypeByExtension(filepath.Ext(key))
+		if attrs.ContentType == "" {
+			attrs.ContentType = "application/octet-stream"
+		}
+	}
+
+	return &storagepb.Blob{
+		Key:          key,
+		Size:         info.Size(),
+		Etag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		LastModified: timestamppb.New(info.ModTime()),
+		ContentType:  attrs.ContentType,
+		CacheControl: attrs.CacheControl,
+		Metadata:     attrs.Metadata,
+	}, nil
+}
+
+// writeBlob writes the contents of r to a file in a bucket, followed by the blob's attributes.
+// The contents are written to a temporary file first so readers never observe a partially written blob.
+func (s *LocalStorageService) writeBlob(blobPath string, r io.Reader, attrs blobAttributes) error {
+	if err := os.MkdirAll(filepath.Dir(blobPath), 0o750); err != nil {
+		return fmt.Errorf("error creating bucket directory: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(blobPath), ".nitric-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := io.Copy(tmpFile, r); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpFile.Name(), blobPath); err != nil {
+		return err
+	}
+
+	attrsJson, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(attributesPath(blobPath), attrsJson, 0o600)
+}
+
+// Write contents to a file in a bucket
+func (s *LocalStorageService) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Write")
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType, _ = detectContentType(req.Key, bytes.NewReader(req.Body))
+	}
+
+	if err := s.writeBlob(blobPath, bytes.NewReader(req.Body), blobAttributes{
+		ContentType:  contentType,
+		CacheControl: req.CacheControl,
+		Metadata:     req.Metadata,
+	}); err != nil {
+		return nil, newErr(codes.Unknown, "error writing file", err)
+	}
+
+	s.notify(req.BucketName, req.Key, blobPath, storagepb.BlobEventType_Created)
+
+	return &storagepb.StorageWriteResponse{}, nil
+}
+
+// readStreamChunkSize is the maximum size of the chunks files are streamed in
+const readStreamChunkSize = 64 * 1024
+
+// ReadStream streams the contents of a file, or a byte range of a file, in a bucket
+func (s *LocalStorageService) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.ReadStream")
+
+	if req.Offset < 0 || req.Length < 0 {
+		return newErr(codes.InvalidArgument, "offset and length cannot be negative", nil)
+	}
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	file, err := os.Open(blobPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return newErr(codes.NotFound, fmt.Sprintf("blob %s not found in bucket %s", req.Key, req.BucketName), err)
+		}
+
+		return newErr(codes.Unknown, "error reading file", err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if req.Length > 0 {
+		reader = io.NewSectionReader(file, req.Offset, req.Length)
+	} else if _, err := file.Seek(req.Offset, io.SeekStart); err != nil {
+		return newErr(codes.Unknown, "error reading file", err)
+	}
+
+	buf := make([]byte, readStreamChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&storagepb.StorageReadStreamResponse{
+				Chunk: append([]byte(nil), buf[:n]...),
+			}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return newErr(codes.Unknown, "error reading file", err)
+		}
+	}
+}
+
+// writeStreamReader reads the chunks of a file from a write stream
+type writeStreamReader struct {
+	stream storagepb.Storage_WriteStreamServer
+	chunk  []byte
+}
+
+func (w *writeStreamReader) Read(p []byte) (int, error) {
+	for len(w.chunk) == 0 {
+		req, err := w.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetMetadata() != nil {
+			return 0, fmt.Errorf("blob metadata can only be sent in the first message of a write stream")
+		}
+
+		w.chunk = req.GetChunk()
+	}
+
+	n := copy(p, w.chunk)
+	w.chunk = w.chunk[n:]
+
+	return n, nil
+}
+
+// WriteStream writes a streamed file to a bucket
+func (s *LocalStorageService) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.WriteStream")
+
+	req, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return newErr(codes.Unknown, "error receiving file", err)
+	}
+
+	metadata := req.GetMetadata()
+	if metadata == nil {
+		return newErr(codes.InvalidArgument, "the first message of a write stream must contain the blob metadata", nil)
+	}
+
+	blobPath, err := s.blobPath(metadata.BucketName, metadata.Key)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	var body io.Reader = &writeStreamReader{stream: stream}
+
+	contentType := metadata.ContentType
+	if contentType == "" {
+		contentType, body = detectContentType(metadata.Key, body)
+	}
+
+	if err := s.writeBlob(blobPath, body, blobAttributes{
+		ContentType:  contentType,
+		CacheControl: metadata.CacheControl,
+		Metadata:     metadata.Metadata,
+	}); err != nil {
+		return newErr(codes.Unknown, "error writing file", err)
+	}
+
+	s.notify(metadata.BucketName, metadata.Key, blobPath, storagepb.BlobEventType_Created)
+
+	return stream.SendAndClose(&storagepb.StorageWriteResponse{})
+}
+
+// Delete a file from a bucket
+func (s *LocalStorageService) Delete(ctx context.Context, req *storagepb.StorageDeleteRequest) (*storagepb.StorageDeleteResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Delete")
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	if err := os.Remove(blobPath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// deleting a blob that doesn't exist is not an error, matching cloud object stores
+			return &storagepb.StorageDeleteResponse{}, nil
+		}
+
+		return nil, newErr(codes.Unknown, "error deleting file", err)
+	}
+
+	if err := os.Remove(attributesPath(blobPath)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, newErr(codes.Unknown, "error deleting file attributes", err)
+	}
+
+	s.notify(req.BucketName, req.Key, blobPath, storagepb.BlobEventType_Deleted)
+
+	return &storagepb.StorageDeleteResponse{}, nil
+}
+
+// copyBlob copies a blob and its attributes to destinationPath
+func (s *LocalStorageService) copyBlob(sourceKey string, sourcePath string, destinationPath string) error {
+	blob, err := s.blob(sourceKey, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	return s.writeBlob(destinationPath, source, blobAttributes{
+		ContentType:  blob.ContentType,
+		CacheControl: blob.CacheControl,
+		Metadata:     blob.Metadata,
+	})
+}
+
+// Copy copies a file to another key, in the same or another bucket
+func (s *LocalStorageService) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Copy")
+
+	sourcePath, err := s.blobPath(req.SourceBucketName, req.SourceKey)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid source blob reference", err)
+	}
+
+	destinationPath, err := s.blobPath(req.DestinationBucketName, req.DestinationKey)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid destination blob reference", err)
+	}
+
+	if err := s.copyBlob(req.SourceKey, sourcePath, destinationPath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("blob %s not found in bucket %s", req.SourceKey, req.SourceBucketName), err)
+		}
+
+		return nil, newErr(codes.Unknown, "error copying file", err)
+	}
+
+	s.notify(req.DestinationBucketName, req.DestinationKey, destinationPath, storagepb.BlobEventType_Created)
+
+	return &storagepb.StorageCopyResponse{}, nil
+}
+
+// Move moves a file to another key, in the same or another bucket, by copying it and deleting the original
+func (s *LocalStorageService) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	if _, err := s.Copy(ctx, &storagepb.StorageCopyRequest{
+		SourceBucketName:      req.SourceBucketName,
+		SourceKey:             req.SourceKey,
+		DestinationBucketName: req.DestinationBucketName,
+		DestinationKey:        req.DestinationKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	// moving a blob onto itself leaves it in place, the paths are valid once the copy succeeds
+	sourcePath, _ := s.blobPath(req.SourceBucketName, req.SourceKey)
+	destinationPath, _ := s.blobPath(req.DestinationBucketName, req.DestinationKey)
+	if sourcePath == destinationPath {
+		return &storagepb.StorageMoveResponse{}, nil
+	}
+
+	if _, err := s.Delete(ctx, &storagepb.StorageDeleteRequest{
+		BucketName: req.SourceBucketName,
+		Key:        req.SourceKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StorageMoveResponse{}, nil
+}
+
+// PreSignUrl generates a URL, served by the local gateway, which can be used to read or write a file directly
+func (s *LocalStorageService) PreSignUrl(ctx context.Context, req *storagepb.StoragePreSignUrlRequest) (*storagepb.StoragePreSignUrlResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.PreSignUrl")
+
+	if _, err := s.blobPath(req.BucketName, req.Key); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	switch req.Operation {
+	case storagepb.StoragePreSignUrlRequest_READ, storagepb.StoragePreSignUrlRequest_WRITE:
+	default:
+		return nil, newErr(codes.Unimplemented, "requested operation not supported for local pre-signed URLs", nil)
+	}
+
+	query := url.Values{}
+	query.Set("operation", req.Operation.String())
+	query.Set("expires", fmt.Sprintf("%d", time.Now().Add(req.Expiry.AsDuration()).Unix()))
+
+	blobUrl := url.URL{
+		Path:     fmt.Sprintf("%s/%s/%s", PreSignRoute, req.BucketName, req.Key),
+		RawQuery: query.Encode(),
+	}
+
+	return &storagepb.StoragePreSignUrlResponse{
+		Url: s.gatewayUrl + blobUrl.String(),
+	}, nil
+}
+
+// ListBlobs lists the files in a bucket, a page at a time when a page size is requested
+func (s *LocalStorageService) ListBlobs(ctx context.Context, req *storagepb.StorageListBlobsRequest) (*storagepb.StorageListBlobsResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.ListBlobs")
+
+	if req.PageSize < 0 {
+		return nil, newErr(codes.InvalidArgument, "page size cannot be negative", nil)
+	}
+
+	bucketPath, err := s.bucketPath(req.BucketName)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid bucket", err)
+	}
+
+	startAfter := ""
+	if req.PageToken != "" {
+		token, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, newErr(codes.InvalidArgument, "invalid page token", err)
+		}
+
+		startAfter = string(token)
+	}
+
+	keys := []string{}
+
+	err = filepath.WalkDir(bucketPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".nitric-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketPath, path)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, req.Prefix) {
+			keys = append(keys, key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErr(codes.Unknown, "error listing files", err)
+	}
+
+	// directories are walked in lexical order of their file names, which isn't the lexical order of the keys
+	sort.Strings(keys)
+
+	resp := &storagepb.StorageListBlobsResponse{
+		Blobs:    []*storagepb.Blob{},
+		Prefixes: []string{},
+	}
+
+	// the name of the last blob or prefix in the page, used as the next page token
+	last := ""
+
+	for _, key := range keys {
+		name := key
+		isPrefix := false
+
+		if req.Delimiter != "" {
+			if i := strings.Index(key[len(req.Prefix):], req.Delimiter); i >= 0 {
+				name = key[:len(req.Prefix)+i+len(req.Delimiter)]
+				isPrefix = true
+			}
+		}
+
+		// keys sharing a prefix are adjacent once sorted, so each prefix is only seen once
+		if name <= startAfter || name == last {
+			continue
+		}
+
+		if req.PageSize > 0 && len(resp.Blobs)+len(resp.Prefixes) == int(req.PageSize) {
+			resp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(last))
+			break
+		}
+
+		last = name
+
+		if isPrefix {
+			resp.Prefixes = append(resp.Prefixes, name)
+			continue
+		}
+
+		blob, err := s.blob(key, filepath.Join(bucketPath, filepath.FromSlash(key)))
+		if err != nil {
+			return nil, newErr(codes.Unknown, "error reading file attributes", err)
+		}
+
+		resp.Blobs = append(resp.Blobs, blob)
+	}
+
+	return resp, nil
+}
+
+func (s *LocalStorageService) Exists(ctx context.Context, req *storagepb.StorageExistsRequest) (*storagepb.StorageExistsResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Exists")
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	info, err := os.Stat(blobPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &storagepb.StorageExistsResponse{
+				Exists: false,
+			}, nil
+		}
+
+		return nil, newErr(codes.Unknown, "error checking if file exists", err)
+	}
+
+	return &storagepb.StorageExistsResponse{
+		Exists: !info.IsDir(),
+	}, nil
+}
+
+// Stat returns the attributes of a file in a bucket
+func (s *LocalStorageService) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalStorageService.Stat")
+
+	blobPath, err := s.blobPath(req.BucketName, req.Key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid blob reference", err)
+	}
+
+	blob, err := s.blob(req.Key, blobPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newErr(codes.NotFound, fmt.Sprintf("blob %s not found in bucket %s", req.Key, req.BucketName), err)
+		}
+
+		return nil, newErr(codes.Unknown, "error reading file attributes", err)
+	}
+
+	return &storagepb.StorageStatResponse{
+		Blob: blob,
+	}, nil
+}
+
+// New creates a new filesystem storage plugin, storing buckets under rootDir.
+// Blob events are forwarded to the given listener, which may be nil.
+func New(rootDir string, gatewayUrl string, listener storage.BucketRequestHandler) (*LocalStorageService, error) {
+	absRoot, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(absRoot, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating local storage directory: %w", err)
+	}
+
+	return &LocalStorageService{
+		rootDir:    absRoot,
+		gatewayUrl: strings.TrimSuffix(gatewayUrl, "/"),
+		listener:   listener,
+	}, nil
+}
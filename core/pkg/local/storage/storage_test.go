@@ -0,0 +1,594 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+// mockReadStream collects the chunks sent by ReadStream
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+// mockWriteStream sends the given messages to WriteStream
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+	closed   bool
+}
+
+func (m *mockWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(*storagepb.StorageWriteResponse) error {
+	m.closed = true
+	return nil
+}
+
+var _ = Describe("LocalStorageService", func() {
+	var (
+		rootDir string
+		service *LocalStorageService
+	)
+
+	BeforeEach(func() {
+		var err error
+		rootDir, err = os.MkdirTemp("", "nitric-storage-*")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		service, err = New(rootDir, "http://localhost:9001/", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(rootDir)
+	})
+
+	Context("Write", func() {
+		When("writing a blob to a bucket", func() {
+			It("should store the blob as a file in the bucket directory", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "nested/test-key",
+					Body:       []byte("Test"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				contents, err := os.ReadFile(filepath.Join(rootDir, "my-bucket", "nested", "test-key"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(contents).To(Equal([]byte("Test")))
+			})
+		})
+
+		When("the key escapes the bucket directory", func() {
+			It("should return an invalid argument error", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "../../outside",
+					Body:       []byte("Test"),
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Read", func() {
+		When("the blob exists", func() {
+			It("should return the blob contents", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Body:       []byte("Test"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Read(context.TODO(), &storagepb.StorageReadRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Body).To(Equal([]byte("Test")))
+			})
+		})
+
+		When("the blob does not exist", func() {
+			It("should return a not found error", func() {
+				_, err := service.Read(context.TODO(), &storagepb.StorageReadRequest{
+					BucketName: "my-bucket",
+					Key:        "missing-key",
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("ReadStream", func() {
+		BeforeEach(func() {
+			_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+				BucketName: "my-bucket",
+				Key:        "test-key",
+				Body:       []byte("Hello World"),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		When("reading the whole blob", func() {
+			It("should stream the blob contents", func() {
+				stream := &mockReadStream{}
+				err := service.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				}, stream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bytes.Join(stream.chunks, nil)).To(Equal([]byte("Hello World")))
+			})
+		})
+
+		When("reading a byte range", func() {
+			It("should stream only the requested range", func() {
+				stream := &mockReadStream{}
+				err := service.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Offset:     6,
+					Length:     3,
+				}, stream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bytes.Join(stream.chunks, nil)).To(Equal([]byte("Wor")))
+			})
+		})
+
+		When("reading from an offset", func() {
+			It("should stream the rest of the blob", func() {
+				stream := &mockReadStream{}
+				err := service.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Offset:     6,
+				}, stream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bytes.Join(stream.chunks, nil)).To(Equal([]byte("World")))
+			})
+		})
+
+		When("the blob does not exist", func() {
+			It("should return a not found error", func() {
+				err := service.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "missing-key",
+				}, &mockReadStream{})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("WriteStream", func() {
+		When("streaming a blob to a bucket", func() {
+			It("should store the chunks as a file in the bucket directory", func() {
+				stream := &mockWriteStream{requests: []*storagepb.StorageWriteStreamRequest{
+					{Content: &storagepb.StorageWriteStreamRequest_Metadata{Metadata: &storagepb.StorageWriteStreamMetadata{
+						BucketName: "my-bucket",
+						Key:        "streamed-key",
+					}}},
+					{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("Hello ")}},
+					{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("World")}},
+				}}
+
+				err := service.WriteStream(stream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.closed).To(BeTrue())
+
+				contents, err := os.ReadFile(filepath.Join(rootDir, "my-bucket", "streamed-key"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(contents).To(Equal([]byte("Hello World")))
+			})
+		})
+
+		When("the stream doesn't start with metadata", func() {
+			It("should return an invalid argument error", func() {
+				stream := &mockWriteStream{requests: []*storagepb.StorageWriteStreamRequest{
+					{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: []byte("Hello")}},
+				}}
+
+				err := service.WriteStream(stream)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Delete", func() {
+		When("the blob exists", func() {
+			It("should remove the file", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Body:       []byte("Test"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.Delete(context.TODO(), &storagepb.StorageDeleteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Exists(context.TODO(), &storagepb.StorageExistsRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Exists).To(BeFalse())
+			})
+		})
+
+		When("the blob does not exist", func() {
+			It("should not return an error", func() {
+				_, err := service.Delete(context.TODO(), &storagepb.StorageDeleteRequest{
+					BucketName: "my-bucket",
+					Key:        "missing-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Copy", func() {
+		When("the source blob exists", func() {
+			It("should copy the blob and its attributes to another bucket", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName:   "my-bucket",
+					Key:          "test-key",
+					Body:         []byte("Test"),
+					ContentType:  "text/plain",
+					CacheControl: "no-cache",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "test-key",
+					DestinationBucketName: "other-bucket",
+					DestinationKey:        "copied-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				readResp, err := service.Read(context.TODO(), &storagepb.StorageReadRequest{
+					BucketName: "other-bucket",
+					Key:        "copied-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(readResp.Body).To(Equal([]byte("Test")))
+
+				statResp, err := service.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "other-bucket",
+					Key:        "copied-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(statResp.Blob.ContentType).To(Equal("text/plain"))
+				Expect(statResp.Blob.CacheControl).To(Equal("no-cache"))
+
+				existsResp, err := service.Exists(context.TODO(), &storagepb.StorageExistsRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(existsResp.Exists).To(BeTrue())
+			})
+		})
+
+		When("the source blob does not exist", func() {
+			It("should return a not found error", func() {
+				_, err := service.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "missing-key",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "copied-key",
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("Move", func() {
+		When("the source blob exists", func() {
+			It("should move the blob to the destination key", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Body:       []byte("Test"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "test-key",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "moved/test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				readResp, err := service.Read(context.TODO(), &storagepb.StorageReadRequest{
+					BucketName: "my-bucket",
+					Key:        "moved/test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(readResp.Body).To(Equal([]byte("Test")))
+
+				existsResp, err := service.Exists(context.TODO(), &storagepb.StorageExistsRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(existsResp.Exists).To(BeFalse())
+			})
+		})
+
+		When("moving a blob onto itself", func() {
+			It("should leave the blob in place", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Body:       []byte("Test"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = service.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "test-key",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				readResp, err := service.Read(context.TODO(), &storagepb.StorageReadRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(readResp.Body).To(Equal([]byte("Test")))
+			})
+		})
+	})
+
+	Context("Stat", func() {
+		When("the blob was written with attributes", func() {
+			It("should return the attributes", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName:   "my-bucket",
+					Key:          "test-key",
+					Body:         []byte("{}"),
+					ContentType:  "application/json",
+					CacheControl: "max-age=60",
+					Metadata:     map[string]string{"owner": "test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blob.Key).To(Equal("test-key"))
+				Expect(resp.Blob.Size).To(Equal(int64(2)))
+				Expect(resp.Blob.ContentType).To(Equal("application/json"))
+				Expect(resp.Blob.CacheControl).To(Equal("max-age=60"))
+				Expect(resp.Blob.Metadata).To(Equal(map[string]string{"owner": "test"}))
+			})
+		})
+
+		When("the blob was written without a content type", func() {
+			It("should detect the content type", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Body:       []byte("plain text"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blob.ContentType).To(Equal("text/plain; charset=utf-8"))
+			})
+		})
+
+		When("the blob does not exist", func() {
+			It("should return a not found error", func() {
+				_, err := service.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "my-bucket",
+					Key:        "missing-key",
+				})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("ListBlobs", func() {
+		BeforeEach(func() {
+			for _, key := range []string{"a/one", "a/two", "b/three"} {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        key,
+					Body:       []byte(key),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		When("listing without a prefix", func() {
+			It("should return all blobs in the bucket", func() {
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blobs).To(HaveLen(3))
+			})
+		})
+
+		When("listing with a prefix", func() {
+			It("should only return matching blobs", func() {
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Prefix:     "a/",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blobs).To(HaveLen(2))
+				for _, blob := range resp.Blobs {
+					Expect(strings.HasPrefix(blob.Key, "a/")).To(BeTrue())
+				}
+			})
+		})
+
+		When("listing blobs", func() {
+			It("should return the attributes of each blob", func() {
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Prefix:     "b/",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blobs).To(HaveLen(1))
+				Expect(resp.Blobs[0].Size).To(Equal(int64(len("b/three"))))
+				Expect(resp.Blobs[0].Etag).ToNot(BeEmpty())
+				Expect(resp.Blobs[0].LastModified).ToNot(BeNil())
+			})
+		})
+
+		When("the bucket does not exist", func() {
+			It("should return an empty list", func() {
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "missing-bucket",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Blobs).To(BeEmpty())
+			})
+		})
+
+		When("listing a page at a time", func() {
+			It("should return every blob across the pages", func() {
+				keys := []string{}
+				token := ""
+
+				for {
+					resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName: "my-bucket",
+						PageSize:   2,
+						PageToken:  token,
+					})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(len(resp.Blobs)).To(BeNumerically("<=", 2))
+
+					for _, blob := range resp.Blobs {
+						keys = append(keys, blob.Key)
+					}
+
+					if resp.NextPageToken == "" {
+						break
+					}
+					token = resp.NextPageToken
+				}
+
+				Expect(keys).To(Equal([]string{"a/one", "a/two", "b/three"}))
+			})
+		})
+
+		When("listing with a delimiter", func() {
+			It("should group nested blobs into prefixes", func() {
+				_, err := service.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName: "my-bucket",
+					Key:        "root",
+					Body:       []byte("root"),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Delimiter:  "/",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Prefixes).To(Equal([]string{"a/", "b/"}))
+				Expect(resp.Blobs).To(HaveLen(1))
+				Expect(resp.Blobs[0].Key).To(Equal("root"))
+			})
+
+			It("should page through prefixes", func() {
+				resp, err := service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Delimiter:  "/",
+					PageSize:   1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Prefixes).To(Equal([]string{"a/"}))
+				Expect(resp.NextPageToken).ToNot(BeEmpty())
+
+				resp, err = service.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Delimiter:  "/",
+					PageSize:   1,
+					PageToken:  resp.NextPageToken,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Prefixes).To(Equal([]string{"b/"}))
+				Expect(resp.NextPageToken).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("PreSignUrl", func() {
+		When("requesting a read URL", func() {
+			It("should return a gateway URL for the blob", func() {
+				resp, err := service.PreSignUrl(context.TODO(), &storagepb.StoragePreSignUrlRequest{
+					BucketName: "my-bucket",
+					Key:        "test-key",
+					Operation:  storagepb.StoragePreSignUrlRequest_READ,
+					Expiry:     durationpb.New(60),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Url).To(HavePrefix("http://localhost:9001" + PreSignRoute + "/my-bucket/test-key?"))
+				Expect(resp.Url).To(ContainSubstring("operation=READ"))
+			})
+		})
+	})
+})
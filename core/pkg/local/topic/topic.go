@@ -0,0 +1,194 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators/topic"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+// maxAttributes is the most attributes a message can be published with, matching the limit of Pub/Sub
+const maxAttributes = 100
+
+// LocalTopicService - an in process implementation of the Nitric Topics Service
+// Published messages are delivered asynchronously to the subscribers registered with this server.
+type LocalTopicService struct {
+	subscribers topics.SubscriptionRequestHandler
+	// messages with an ordering key waiting to be delivered, in the order they were published
+	ordered     map[string][]*topicspb.MessageRequest
+	orderedLock sync.Mutex
+}
+
+var _ topicspb.TopicsServer = (*LocalTopicService)(nil)
+
+func (s *LocalTopicService) deliver(request *topicspb.MessageRequest) {
+	resp, err := s.subscribers.HandleRequest(context.Background(), &topicspb.ServerMessage{
+		Content: &topicspb.ServerMessage_MessageRequest{
+			MessageRequest: request,
+		},
+	})
+	if err != nil {
+		logger.Errorf("error delivering message to subscribers of topic %s: %v", request.TopicName, err)
+		return
+	}
+
+	if !resp.GetMessageResponse().GetSuccess() {
+		logger.Warnf("subscribers of topic %s did not successfully handle message", request.TopicName)
+	}
+}
+
+// deliverOrdered queues a message for delivery after the messages previously published with the same ordering key
+func (s *LocalTopicService) deliverOrdered(request *topicspb.MessageRequest) {
+	key := request.TopicName + "/" + request.OrderingKey
+
+	s.orderedLock.Lock()
+	s.ordered[key] = append(s.ordered[key], request)
+	delivering := len(s.ordered[key]) > 1
+	s.orderedLock.Unlock()
+
+	// the messages for this key are already being delivered
+	if delivering {
+		return
+	}
+
+	go func() {
+		for {
+			s.orderedLock.Lock()
+			next := s.ordered[key][0]
+			s.orderedLock.Unlock()
+
+			s.deliver(next)
+
+			s.orderedLock.Lock()
+			s.ordered[key] = s.ordered[key][1:]
+			if len(s.ordered[key]) == 0 {
+				delete(s.ordered, key)
+				s.orderedLock.Unlock()
+				return
+			}
+			s.orderedLock.Unlock()
+		}
+	}()
+}
+
+// Publish a message to a topic, delivering it to subscribers after the requested delay
+func (s *LocalTopicService) Publish(ctx context.Context, req *topicspb.TopicPublishRequest) (*topicspb.TopicPublishResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalTopicService.Publish")
+
+	if req.TopicName == "" {
+		return nil, newErr(codes.InvalidArgument, "topic name cannot be empty", nil)
+	}
+
+	if req.Message == nil {
+		return nil, newErr(codes.InvalidArgument, "message cannot be empty", nil)
+	}
+
+	if err := topic.ValidateAttributes(req.Attributes, maxAttributes); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid attributes", err)
+	}
+
+	if err := topic.ValidateOrderingKey(req.OrderingKey, req.Delay); err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid ordering key", err)
+	}
+
+	request := &topicspb.MessageRequest{
+		TopicName:   req.TopicName,
+		Message:     req.Message,
+		Attributes:  req.Attributes,
+		OrderingKey: req.OrderingKey,
+	}
+
+	if req.OrderingKey != "" {
+		s.deliverOrdered(request)
+		return &topicspb.TopicPublishResponse{}, nil
+	}
+
+	delay := time.Duration(0)
+	if req.Delay != nil {
+		delay = req.Delay.AsDuration()
+	}
+
+	time.AfterFunc(delay, func() {
+		s.deliver(request)
+	})
+
+	return &topicspb.TopicPublishResponse{}, nil
+}
+
+// validateBatchMessage checks a message of a batch can be published
+func validateBatchMessage(message *topicspb.TopicBatchMessage) error {
+	if message.Message == nil {
+		return fmt.Errorf("message cannot be empty")
+	}
+
+	return topic.ValidateAttributes(message.Attributes, maxAttributes)
+}
+
+// PublishBatch publishes multiple messages to a topic, returning the messages that failed validation
+func (s *LocalTopicService) PublishBatch(ctx context.Context, req *topicspb.TopicPublishBatchRequest) (*topicspb.TopicPublishBatchResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalTopicService.PublishBatch")
+
+	if req.TopicName == "" {
+		return nil, newErr(codes.InvalidArgument, "topic name cannot be empty", nil)
+	}
+
+	failedMessages := []*topicspb.FailedPublishMessage{}
+
+	for _, message := range req.Messages {
+		if err := validateBatchMessage(message); err != nil {
+			failedMessages = append(failedMessages, &topicspb.FailedPublishMessage{
+				Message: message,
+				Details: err.Error(),
+			})
+			continue
+		}
+
+		request := &topicspb.MessageRequest{
+			TopicName:   req.TopicName,
+			Message:     message.Message,
+			Attributes:  message.Attributes,
+			OrderingKey: message.OrderingKey,
+		}
+
+		if message.OrderingKey != "" {
+			s.deliverOrdered(request)
+			continue
+		}
+
+		go s.deliver(request)
+	}
+
+	return &topicspb.TopicPublishBatchResponse{
+		FailedMessages: failedMessages,
+	}, nil
+}
+
+// New creates a new local topics plugin, delivering messages to the given subscribers
+func New(subscribers topics.SubscriptionRequestHandler) (*LocalTopicService, error) {
+	return &LocalTopicService{
+		subscribers: subscribers,
+		ordered:     map[string][]*topicspb.MessageRequest{},
+	}, nil
+}
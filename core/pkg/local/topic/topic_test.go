@@ -0,0 +1,176 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+// recordingSubscribers records the message requests delivered to it
+type recordingSubscribers struct {
+	topics.SubscriptionRequestHandler
+	lock     sync.Mutex
+	requests []*topicspb.MessageRequest
+}
+
+func (r *recordingSubscribers) HandleRequest(ctx context.Context, request *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
+	// slow deliveries, so messages published together overlap
+	time.Sleep(5 * time.Millisecond)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.requests = append(r.requests, request.GetMessageRequest())
+
+	return &topicspb.ClientMessage{
+		Content: &topicspb.ClientMessage_MessageResponse{
+			MessageResponse: &topicspb.MessageResponse{Success: true},
+		},
+	}, nil
+}
+
+func (r *recordingSubscribers) delivered() []*topicspb.MessageRequest {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]*topicspb.MessageRequest{}, r.requests...)
+}
+
+var _ = Describe("LocalTopicService", func() {
+	var subscribers *recordingSubscribers
+	var service *LocalTopicService
+
+	BeforeEach(func() {
+		var err error
+		subscribers = &recordingSubscribers{}
+		service, err = New(subscribers)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Publish", func() {
+		When("publishing with attributes", func() {
+			It("should deliver the attributes to subscribers", func() {
+				_, err := service.Publish(context.TODO(), &topicspb.TopicPublishRequest{
+					TopicName:  "test-topic",
+					Message:    &topicspb.TopicMessage{},
+					Attributes: map[string]string{"region": "eu"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Eventually(subscribers.delivered).Should(HaveLen(1))
+				Expect(subscribers.delivered()[0].Attributes).To(Equal(map[string]string{"region": "eu"}))
+			})
+
+			It("should reject reserved attributes", func() {
+				_, err := service.Publish(context.TODO(), &topicspb.TopicPublishRequest{
+					TopicName:  "test-topic",
+					Message:    &topicspb.TopicMessage{},
+					Attributes: map[string]string{"x-nitric-topic": "other-topic"},
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("publishing with an ordering key", func() {
+			It("should deliver messages with the same key in the order they were published", func() {
+				keys := []string{"1", "2", "3", "4", "5"}
+				for _, key := range keys {
+					_, err := service.Publish(context.TODO(), &topicspb.TopicPublishRequest{
+						TopicName:   "test-topic",
+						Message:     &topicspb.TopicMessage{IdempotencyKey: key},
+						OrderingKey: "test-key",
+					})
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+
+				Eventually(subscribers.delivered).Should(HaveLen(len(keys)))
+				for i, request := range subscribers.delivered() {
+					Expect(request.Message.IdempotencyKey).To(Equal(keys[i]))
+					Expect(request.OrderingKey).To(Equal("test-key"))
+				}
+			})
+
+			It("should reject a delay", func() {
+				_, err := service.Publish(context.TODO(), &topicspb.TopicPublishRequest{
+					TopicName:   "test-topic",
+					Message:     &topicspb.TopicMessage{},
+					OrderingKey: "test-key",
+					Delay:       durationpb.New(time.Second),
+				})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("PublishBatch", func() {
+		When("publishing a batch with an invalid message", func() {
+			It("should deliver the valid messages and return the invalid one as failed", func() {
+				invalid := &topicspb.TopicBatchMessage{
+					Message:    &topicspb.TopicMessage{IdempotencyKey: "2"},
+					Attributes: map[string]string{"x-nitric-topic": "other-topic"},
+				}
+
+				resp, err := service.PublishBatch(context.TODO(), &topicspb.TopicPublishBatchRequest{
+					TopicName: "test-topic",
+					Messages: []*topicspb.TopicBatchMessage{
+						{Message: &topicspb.TopicMessage{IdempotencyKey: "1"}, Attributes: map[string]string{"region": "eu"}},
+						invalid,
+						{Message: &topicspb.TopicMessage{IdempotencyKey: "3"}},
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(HaveLen(1))
+				Expect(resp.FailedMessages[0].Message).To(Equal(invalid))
+
+				Eventually(subscribers.delivered).Should(HaveLen(2))
+				Consistently(subscribers.delivered, "100ms").Should(HaveLen(2))
+			})
+		})
+
+		When("publishing a batch with an ordering key", func() {
+			It("should deliver the messages in the order of the batch", func() {
+				keys := []string{"1", "2", "3", "4", "5"}
+				messages := []*topicspb.TopicBatchMessage{}
+				for _, key := range keys {
+					messages = append(messages, &topicspb.TopicBatchMessage{
+						Message:     &topicspb.TopicMessage{IdempotencyKey: key},
+						OrderingKey: "test-key",
+					})
+				}
+
+				resp, err := service.PublishBatch(context.TODO(), &topicspb.TopicPublishBatchRequest{
+					TopicName: "test-topic",
+					Messages:  messages,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedMessages).To(BeEmpty())
+
+				Eventually(subscribers.delivered).Should(HaveLen(len(keys)))
+				for i, request := range subscribers.delivered() {
+					Expect(request.Message.IdempotencyKey).To(Equal(keys[i]))
+				}
+			})
+		})
+	})
+})
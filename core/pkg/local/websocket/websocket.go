@@ -0,0 +1,235 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/fasthttp/websocket"
+	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc/codes"
+
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+	"github.com/nitrictech/nitric/core/pkg/workers/websockets"
+)
+
+// SocketRoute - the gateway route that accepts websocket connections
+const SocketRoute = "/x-nitric-websocket"
+
+// connection serializes writes to a single websocket connection
+type connection struct {
+	conn *websocket.Conn
+	lock sync.Mutex
+}
+
+func (c *connection) write(messageType int, data []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// LocalWebsocketService - an in process implementation of the Nitric Websocket Service
+// Connections are accepted by the local gateway and their events forwarded to the registered websocket handlers.
+type LocalWebsocketService struct {
+	gatewayUrl  string
+	upgrader    websocket.FastHTTPUpgrader
+	connections map[string]map[string]*connection
+	lock        sync.RWMutex
+}
+
+var _ websocketspb.WebsocketServer = (*LocalWebsocketService)(nil)
+
+func (s *LocalWebsocketService) getConnection(socketName string, connectionId string) (*connection, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	conn, ok := s.connections[socketName][connectionId]
+	if !ok {
+		return nil, fmt.Errorf("connection %s not found for socket %s", connectionId, socketName)
+	}
+
+	return conn, nil
+}
+
+func (s *LocalWebsocketService) addConnection(socketName string, connectionId string, conn *connection) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.connections[socketName] == nil {
+		s.connections[socketName] = map[string]*connection{}
+	}
+
+	s.connections[socketName][connectionId] = conn
+}
+
+func (s *LocalWebsocketService) removeConnection(socketName string, connectionId string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.connections[socketName], connectionId)
+
+	if len(s.connections[socketName]) == 0 {
+		delete(s.connections, socketName)
+	}
+}
+
+func (s *LocalWebsocketService) SocketDetails(ctx context.Context, req *websocketspb.WebsocketDetailsRequest) (*websocketspb.WebsocketDetailsResponse, error) {
+	wsUrl := strings.Replace(s.gatewayUrl, "http", "ws", 1)
+
+	return &websocketspb.WebsocketDetailsResponse{
+		Url: fmt.Sprintf("%s%s/%s", wsUrl, SocketRoute, req.SocketName),
+	}, nil
+}
+
+func (s *LocalWebsocketService) SendMessage(ctx context.Context, req *websocketspb.WebsocketSendRequest) (*websocketspb.WebsocketSendResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalWebsocketService.SendMessage")
+
+	conn, err := s.getConnection(req.SocketName, req.ConnectionId)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding websocket connection", err)
+	}
+
+	if err := conn.write(websocket.BinaryMessage, req.Data); err != nil {
+		return nil, newErr(codes.Internal, "error sending message to websocket", err)
+	}
+
+	return &websocketspb.WebsocketSendResponse{}, nil
+}
+
+func (s *LocalWebsocketService) CloseConnection(ctx context.Context, req *websocketspb.WebsocketCloseConnectionRequest) (*websocketspb.WebsocketCloseConnectionResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("LocalWebsocketService.CloseConnection")
+
+	conn, err := s.getConnection(req.SocketName, req.ConnectionId)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding websocket connection", err)
+	}
+
+	// closing the underlying connection ends the read loop, which emits the disconnect event
+	if err := conn.conn.Close(); err != nil {
+		return nil, newErr(codes.Internal, "error closing websocket connection", err)
+	}
+
+	return &websocketspb.WebsocketCloseConnectionResponse{}, nil
+}
+
+func newEventRequest(socketName string, connectionId string) *websocketspb.WebsocketEventRequest {
+	return &websocketspb.WebsocketEventRequest{
+		SocketName:   socketName,
+		ConnectionId: connectionId,
+	}
+}
+
+func sendEvent(ctx context.Context, handler websockets.WebsocketRequestHandler, event *websocketspb.WebsocketEventRequest) (*websocketspb.ClientMessage, error) {
+	return handler.HandleRequest(ctx, &websocketspb.ServerMessage{
+		Content: &websocketspb.ServerMessage_WebsocketEventRequest{
+			WebsocketEventRequest: event,
+		},
+	})
+}
+
+// Serve accepts a new websocket connection for a socket, forwarding its connect, message and disconnect events to handler
+func (s *LocalWebsocketService) Serve(ctx *fasthttp.RequestCtx, socketName string, handler websockets.WebsocketRequestHandler) {
+	connectionId := workers.GenerateUniqueId()
+
+	queryParams := map[string]*websocketspb.QueryValue{}
+	ctx.QueryArgs().VisitAll(func(key []byte, val []byte) {
+		k := string(key)
+
+		if queryParams[k] == nil {
+			queryParams[k] = &websocketspb.QueryValue{}
+		}
+
+		queryParams[k].Value = append(queryParams[k].Value, string(val))
+	})
+
+	connectEvent := newEventRequest(socketName, connectionId)
+	connectEvent.WebsocketEvent = &websocketspb.WebsocketEventRequest_Connection{
+		Connection: &websocketspb.WebsocketConnectionEvent{
+			QueryParams: queryParams,
+		},
+	}
+
+	resp, err := sendEvent(ctx, handler, connectEvent)
+	if err != nil {
+		logger.Errorf("error handling websocket connection for socket %s: %v", socketName, err)
+		ctx.Error("Internal Server Error", fasthttp.StatusInternalServerError)
+		return
+	}
+
+	if resp.GetWebsocketEventResponse().GetConnectionResponse().GetReject() {
+		ctx.Error("Forbidden", fasthttp.StatusForbidden)
+		return
+	}
+
+	err = s.upgrader.Upgrade(ctx, func(conn *websocket.Conn) {
+		s.addConnection(socketName, connectionId, &connection{conn: conn})
+
+		defer func() {
+			s.removeConnection(socketName, connectionId)
+			_ = conn.Close()
+
+			disconnectEvent := newEventRequest(socketName, connectionId)
+			disconnectEvent.WebsocketEvent = &websocketspb.WebsocketEventRequest_Disconnection{
+				Disconnection: &websocketspb.WebsocketDisconnectionEvent{},
+			}
+
+			if _, err := sendEvent(context.Background(), handler, disconnectEvent); err != nil {
+				logger.Debugf("websocket disconnect for socket %s not handled: %v", socketName, err)
+			}
+		}()
+
+		for {
+			_, body, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			messageEvent := newEventRequest(socketName, connectionId)
+			messageEvent.WebsocketEvent = &websocketspb.WebsocketEventRequest_Message{
+				Message: &websocketspb.WebsocketMessageEvent{
+					Body: body,
+				},
+			}
+
+			if _, err := sendEvent(context.Background(), handler, messageEvent); err != nil {
+				logger.Errorf("error handling websocket message for socket %s: %v", socketName, err)
+			}
+		}
+	})
+	if err != nil {
+		logger.Errorf("error upgrading websocket connection for socket %s: %v", socketName, err)
+	}
+}
+
+// New creates a new local websocket plugin, with socket URLs relative to gatewayUrl
+func New(gatewayUrl string) (*LocalWebsocketService, error) {
+	return &LocalWebsocketService{
+		gatewayUrl: strings.TrimSuffix(gatewayUrl, "/"),
+		upgrader: websocket.FastHTTPUpgrader{
+			// local development clients are commonly served from a different origin
+			CheckOrigin: func(ctx *fasthttp.RequestCtx) bool {
+				return true
+			},
+		},
+		connections: map[string]map[string]*connection{},
+	}, nil
+}
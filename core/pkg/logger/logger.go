@@ -15,9 +15,15 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/nitrictech/nitric/core/pkg/env"
 )
@@ -32,12 +38,22 @@ const (
 	FATAL
 )
 
+// LevelFatal is the slog level of fatal errors, the process exits once they're logged
+const LevelFatal = slog.Level(12)
+
 var levelNames = [...]string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
 
+var slogLevels = [...]slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError, LevelFatal}
+
 func (l LogLevel) String() string {
 	return levelNames[l]
 }
 
+// Level returns the equivalent slog level
+func (l LogLevel) Level() slog.Level {
+	return slogLevels[l]
+}
+
 func LogLevelFromString(level string) LogLevel {
 	for i, name := range levelNames {
 		if name == level {
@@ -47,64 +63,184 @@ func LogLevelFromString(level string) LogLevel {
 	return INFO // default to INFO
 }
 
-var logLevel = LogLevelFromString(env.LOG_LEVEL.String())
+// levelName returns the name of a slog level, using the nitric name for levels that have one
+func levelName(level slog.Level) string {
+	for i, l := range slogLevels {
+		if l == level {
+			return levelNames[i]
+		}
+	}
+	return level.String()
+}
+
+// LogFormat determines how log lines are written
+type LogFormat string
+
+const (
+	// TextFormat writes human readable lines, followed by any fields as key=value pairs
+	TextFormat LogFormat = "text"
+	// JsonFormat writes each line as a JSON object
+	JsonFormat LogFormat = "json"
+	// LogfmtFormat writes each line as key=value pairs
+	LogfmtFormat LogFormat = "logfmt"
+)
+
+func LogFormatFromString(format string) LogFormat {
+	switch f := LogFormat(strings.ToLower(format)); f {
+	case TextFormat, JsonFormat, LogfmtFormat:
+		return f
+	default:
+		return TextFormat // default to text
+	}
+}
+
+var (
+	logLevel            = LogLevelFromString(env.LOG_LEVEL.String())
+	logFormat           = LogFormatFromString(env.LOG_FORMAT.String())
+	output    io.Writer = os.Stderr
+
+	level   = &slog.LevelVar{}
+	current atomic.Pointer[slog.Logger]
+	lock    sync.Mutex
+)
 
-func SetLogLevel(level LogLevel) {
-	logLevel = level
+func init() {
+	level.Set(logLevel.Level())
+	configure()
+}
+
+// configure replaces the structured logger with one using the current format and output
+func configure() {
+	opts := &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.LevelKey && len(groups) == 0 {
+				if l, ok := a.Value.Any().(slog.Level); ok {
+					return slog.String(slog.LevelKey, levelName(l))
+				}
+			}
+			return a
+		},
+	}
+
+	var handler slog.Handler
+	switch logFormat {
+	case JsonFormat:
+		handler = slog.NewJSONHandler(output, opts)
+	case LogfmtFormat:
+		handler = slog.NewTextHandler(output, opts)
+	default:
+		handler = newTextHandler(output, level)
+	}
+
+	current.Store(slog.New(&contextHandler{handler}))
+}
+
+func SetLogLevel(l LogLevel) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	logLevel = l
+	level.Set(l.Level())
 }
 
 func GetLogLevel() LogLevel {
+	lock.Lock()
+	defer lock.Unlock()
+
 	return logLevel
 }
 
+// SetLogFormat changes the format of subsequent log lines
+func SetLogFormat(format LogFormat) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	logFormat = format
+	configure()
+}
+
 func SetOutput(w io.Writer) {
+	lock.Lock()
+	defer lock.Unlock()
+
 	log.SetOutput(w)
+	output = w
+	configure()
 }
 
-func logMsg(level LogLevel, msg string) {
-	if level >= logLevel {
-		if level == FATAL {
-			log.Fatalf("%s %s\n", level.String(), msg)
-		} else {
-			log.Printf("%s %s\n", level.String(), msg)
-		}
+// Logger returns the structured logger, for logging with key/value fields
+func Logger() *slog.Logger {
+	return current.Load()
+}
+
+// With returns a structured logger that includes the given key/value fields on every line
+func With(args ...any) *slog.Logger {
+	return Logger().With(args...)
+}
+
+func logMsg(ctx context.Context, level LogLevel, msg string, args ...any) {
+	Logger().Log(ctx, level.Level(), msg, args...)
+
+	if level == FATAL {
+		os.Exit(1)
 	}
 }
 
 func Debug(msg string) {
-	logMsg(DEBUG, msg)
+	logMsg(context.Background(), DEBUG, msg)
 }
 
 func Debugf(format string, v ...interface{}) {
 	Debug(fmt.Sprintf(format, v...))
 }
 
+// DebugContext logs at DEBUG with key/value fields, including the request and trace IDs of ctx
+func DebugContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, DEBUG, msg, args...)
+}
+
 func Info(msg string) {
-	logMsg(INFO, msg)
+	logMsg(context.Background(), INFO, msg)
 }
 
 func Infof(format string, v ...interface{}) {
 	Info(fmt.Sprintf(format, v...))
 }
 
+// InfoContext logs at INFO with key/value fields, including the request and trace IDs of ctx
+func InfoContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, INFO, msg, args...)
+}
+
 func Warn(msg string) {
-	logMsg(WARN, msg)
+	logMsg(context.Background(), WARN, msg)
 }
 
 func Warnf(format string, v ...interface{}) {
 	Warn(fmt.Sprintf(format, v...))
 }
 
+// WarnContext logs at WARN with key/value fields, including the request and trace IDs of ctx
+func WarnContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, WARN, msg, args...)
+}
+
 func Error(msg string) {
-	logMsg(ERROR, msg)
+	logMsg(context.Background(), ERROR, msg)
 }
 
 func Errorf(format string, v ...interface{}) {
 	Error(fmt.Sprintf(format, v...))
 }
 
+// ErrorContext logs at ERROR with key/value fields, including the request and trace IDs of ctx
+func ErrorContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, ERROR, msg, args...)
+}
+
 func Fatal(msg string) {
-	logMsg(FATAL, msg)
+	logMsg(context.Background(), FATAL, msg)
 }
 
 func Fatalf(format string, v ...interface{}) {
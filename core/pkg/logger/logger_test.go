@@ -0,0 +1,122 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+var _ = Describe("Logger", func() {
+	var output *bytes.Buffer
+	var previousLevel logger.LogLevel
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		previousLevel = logger.GetLogLevel()
+		logger.SetOutput(output)
+		logger.SetLogLevel(logger.DEBUG)
+	})
+
+	AfterEach(func() {
+		logger.SetOutput(os.Stderr)
+		logger.SetLogFormat(logger.TextFormat)
+		logger.SetLogLevel(previousLevel)
+	})
+
+	// jsonLine decodes the only line written to output
+	jsonLine := func() map[string]any {
+		line := map[string]any{}
+		Expect(json.Unmarshal(output.Bytes(), &line)).To(Succeed())
+		return line
+	}
+
+	When("using the text format", func() {
+		It("should write the level and message followed by any fields", func() {
+			logger.With("bucket", "images").Warn("blob not found")
+
+			Expect(output.String()).To(MatchRegexp(`^\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2} WARN blob not found bucket=images\n$`))
+		})
+
+		It("should quote field values containing spaces", func() {
+			logger.InfoContext(context.Background(), "started", "command", "node index.js")
+
+			Expect(output.String()).To(ContainSubstring(`command="node index.js"`))
+		})
+	})
+
+	When("using the json format", func() {
+		BeforeEach(func() {
+			logger.SetLogFormat(logger.JsonFormat)
+		})
+
+		It("should write each line as a JSON object", func() {
+			logger.Errorf("failed after %d attempts", 3)
+
+			line := jsonLine()
+			Expect(line).To(HaveKeyWithValue("level", "ERROR"))
+			Expect(line).To(HaveKeyWithValue("msg", "failed after 3 attempts"))
+			Expect(line).To(HaveKey("time"))
+		})
+
+		It("should include the request ID and trace context of ctx", func() {
+			traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+			spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+
+			ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceId,
+				SpanID:  spanId,
+			}))
+			ctx = logger.WithRequestId(ctx, "request-1")
+
+			logger.DebugContext(ctx, "forwarding request", "worker_type", "Api")
+
+			line := jsonLine()
+			Expect(line).To(HaveKeyWithValue("worker_type", "Api"))
+			Expect(line).To(HaveKeyWithValue("request_id", "request-1"))
+			Expect(line).To(HaveKeyWithValue("trace_id", "4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(line).To(HaveKeyWithValue("span_id", "00f067aa0ba902b7"))
+		})
+	})
+
+	When("using the logfmt format", func() {
+		It("should write each line as key=value pairs", func() {
+			logger.SetLogFormat(logger.LogfmtFormat)
+
+			logger.With("topic", "updates").Info("published")
+
+			Expect(output.String()).To(MatchRegexp(`^time=\S+ level=INFO msg=published topic=updates\n$`))
+		})
+	})
+
+	When("a line is below the log level", func() {
+		It("should not be written", func() {
+			logger.SetLogLevel(logger.WARN)
+
+			logger.Info("ignored")
+			logger.DebugContext(context.Background(), "ignored")
+
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+})
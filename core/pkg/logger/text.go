@@ -0,0 +1,132 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const textTimeFormat = "2006/01/02 15:04:05"
+
+// textHandler writes human readable lines in the format of the standard log package,
+// e.g. "2006/01/02 15:04:05 INFO message key=value"
+type textHandler struct {
+	w      io.Writer
+	lock   *sync.Mutex
+	level  slog.Leveler
+	attrs  []slog.Attr
+	prefix string
+}
+
+var _ slog.Handler = (*textHandler)(nil)
+
+func (h *textHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *textHandler) Handle(_ context.Context, r slog.Record) error {
+	line := &strings.Builder{}
+
+	if !r.Time.IsZero() {
+		line.WriteString(r.Time.Format(textTimeFormat))
+		line.WriteString(" ")
+	}
+
+	line.WriteString(levelName(r.Level))
+	line.WriteString(" ")
+	line.WriteString(r.Message)
+
+	for _, attr := range h.attrs {
+		writeAttr(line, "", attr)
+	}
+
+	r.Attrs(func(attr slog.Attr) bool {
+		writeAttr(line, h.prefix, attr)
+		return true
+	})
+
+	line.WriteString("\n")
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	_, err := io.WriteString(h.w, line.String())
+	return err
+}
+
+// writeAttr appends an attribute as a key=value pair, flattening groups into dotted keys
+func writeAttr(line *strings.Builder, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix = prefix + attr.Key + "."
+		}
+
+		for _, groupAttr := range attr.Value.Group() {
+			writeAttr(line, groupPrefix, groupAttr)
+		}
+		return
+	}
+
+	value := attr.Value.String()
+	if value == "" || strings.ContainsAny(value, " =\"\n\t") {
+		value = strconv.Quote(value)
+	}
+
+	fmt.Fprintf(line, " %s%s=%s", prefix, attr.Key, value)
+}
+
+func (h *textHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	clone.attrs = append(clone.attrs, h.attrs...)
+
+	for _, attr := range attrs {
+		// keys are prefixed now, since the group may change before the line is written
+		clone.attrs = append(clone.attrs, slog.Attr{Key: h.prefix + attr.Key, Value: attr.Value})
+	}
+
+	return &clone
+}
+
+func (h *textHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	clone := *h
+	clone.prefix = h.prefix + name + "."
+
+	return &clone
+}
+
+func newTextHandler(w io.Writer, level slog.Leveler) *textHandler {
+	return &textHandler{
+		w:     w,
+		lock:  &sync.Mutex{},
+		level: level,
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+)
+
+// RegisteredWorkersFunc returns the workers currently registered with the server
+type RegisteredWorkersFunc = func() []*introspectionpb.RegisteredWorker
+
+// registeredWorkersCollector reports the number of registered workers of each type at collection time
+type registeredWorkersCollector struct {
+	registeredWorkers RegisteredWorkersFunc
+	desc              *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*registeredWorkersCollector)(nil)
+
+func (c *registeredWorkersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *registeredWorkersCollector) Collect(ch chan<- prometheus.Metric) {
+	counts := map[introspectionpb.WorkerType]int32{}
+	for workerType := range introspectionpb.WorkerType_name {
+		counts[introspectionpb.WorkerType(workerType)] = 0
+	}
+
+	for _, worker := range c.registeredWorkers() {
+		counts[worker.Type] += worker.WorkerCount
+	}
+
+	for workerType, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), workerType.String())
+	}
+}
+
+// workerInFlightCollector reports the requests awaiting a response from each running worker connection
+type workerInFlightCollector struct {
+	workers sync.Map
+	desc    *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*workerInFlightCollector)(nil)
+
+func (c *workerInFlightCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *workerInFlightCollector) Collect(ch chan<- prometheus.Metric) {
+	c.workers.Range(func(id, inFlight any) bool {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(inFlight.(func() int64)()), id.(string))
+		return true
+	})
+}
+
+var workerInFlight = &workerInFlightCollector{
+	desc: prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "worker_requests_in_flight"),
+		"Requests sent to a worker connection that are still awaiting a response.",
+		[]string{"worker_id"},
+		nil,
+	),
+}
+
+// TrackWorkerInFlight reports the in flight requests of a worker connection until it's untracked
+func TrackWorkerInFlight(workerId string, inFlight func() int64) {
+	workerInFlight.workers.Store(workerId, inFlight)
+}
+
+// UntrackWorkerInFlight stops reporting the in flight requests of a worker connection
+func UntrackWorkerInFlight(workerId string) {
+	workerInFlight.workers.Delete(workerId)
+}
+
+// NewRegisteredWorkersCollector creates a collector reporting the number of workers registered for each worker type
+func NewRegisteredWorkersCollector(registeredWorkers RegisteredWorkersFunc) prometheus.Collector {
+	return &registeredWorkersCollector{
+		registeredWorkers: registeredWorkers,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "registered_workers"),
+			"Workers currently registered with the nitric server, by worker type.",
+			[]string{"worker_type"},
+			nil,
+		),
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+)
+
+const namespace = "nitric"
+
+// Outcomes of a request forwarded to a worker
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	OutcomeTimeout = "timeout"
+)
+
+var (
+	// WorkerRequestDuration tracks how long workers take to handle requests forwarded by the worker managers
+	WorkerRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "worker_request_duration_seconds",
+		Help:      "Duration of requests forwarded to workers, by worker type and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"worker_type", "outcome"})
+
+	// GrpcRequests counts the gRPC calls handled by the server, by service, method and status code
+	GrpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "gRPC calls handled by the nitric server, by service, method and status code.",
+	}, []string{"service", "method", "code"})
+
+	// ProcessRestarts counts the restarts of child processes run by the server
+	ProcessRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "process_restarts_total",
+		Help:      "Restarts of child processes run by the nitric server.",
+	}, []string{"command"})
+)
+
+// WorkerRequestTimer observes the duration of a single worker request
+type WorkerRequestTimer struct {
+	workerType introspectionpb.WorkerType
+	start      time.Time
+}
+
+// ObserveDuration records the time elapsed since the timer started, the outcome is determined by err
+func (t *WorkerRequestTimer) ObserveDuration(err error) {
+	WorkerRequestDuration.WithLabelValues(t.workerType.String(), outcome(err)).Observe(time.Since(t.start).Seconds())
+}
+
+// NewWorkerRequestTimer starts timing a request forwarded to a worker of the given type
+func NewWorkerRequestTimer(workerType introspectionpb.WorkerType) *WorkerRequestTimer {
+	return &WorkerRequestTimer{
+		workerType: workerType,
+		start:      time.Now(),
+	}
+}
+
+func outcome(err error) string {
+	if err == nil {
+		return OutcomeSuccess
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return OutcomeTimeout
+	}
+
+	return OutcomeError
+}
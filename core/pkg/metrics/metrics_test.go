@@ -0,0 +1,124 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func noWorkers() []*introspectionpb.RegisteredWorker {
+	return nil
+}
+
+// gatherText returns the metrics of a registry in the Prometheus text format
+func gatherText(registry *prometheus.Registry) string {
+	families, err := registry.Gather()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var text strings.Builder
+	for _, family := range families {
+		_, err := expfmt.MetricFamilyToText(&text, family)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	return text.String()
+}
+
+var _ = Describe("Metrics", func() {
+	Context("WorkerRequestTimer", func() {
+		It("should label observations with the worker type and outcome", func() {
+			metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Job).ObserveDuration(nil)
+			metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Job).ObserveDuration(fmt.Errorf("worker failed"))
+			metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Job).ObserveDuration(fmt.Errorf("waiting: %w", context.DeadlineExceeded))
+
+			text := gatherText(metrics.NewRegistry(noWorkers))
+
+			Expect(text).To(ContainSubstring(`nitric_worker_request_duration_seconds_count{outcome="success",worker_type="Job"} 1`))
+			Expect(text).To(ContainSubstring(`nitric_worker_request_duration_seconds_count{outcome="error",worker_type="Job"} 1`))
+			Expect(text).To(ContainSubstring(`nitric_worker_request_duration_seconds_count{outcome="timeout",worker_type="Job"} 1`))
+		})
+	})
+
+	Context("Registered workers", func() {
+		It("should report the worker count of every worker type", func() {
+			registry := metrics.NewRegistry(func() []*introspectionpb.RegisteredWorker {
+				return []*introspectionpb.RegisteredWorker{
+					{Type: introspectionpb.WorkerType_Api, Name: "main", Trigger: "GET /a", WorkerCount: 2},
+					{Type: introspectionpb.WorkerType_Api, Name: "main", Trigger: "GET /b", WorkerCount: 1},
+					{Type: introspectionpb.WorkerType_Schedule, Name: "nightly", Trigger: "nightly", WorkerCount: 1},
+				}
+			})
+
+			text := gatherText(registry)
+
+			Expect(text).To(ContainSubstring(`nitric_registered_workers{worker_type="Api"} 3`))
+			Expect(text).To(ContainSubstring(`nitric_registered_workers{worker_type="Schedule"} 1`))
+			Expect(text).To(ContainSubstring(`nitric_registered_workers{worker_type="Job"} 0`))
+		})
+	})
+
+	Context("Worker requests in flight", func() {
+		It("should report tracked workers until they're untracked", func() {
+			metrics.TrackWorkerInFlight("worker-1", func() int64 { return 4 })
+
+			Expect(gatherText(metrics.NewRegistry(noWorkers))).To(ContainSubstring(`nitric_worker_requests_in_flight{worker_id="worker-1"} 4`))
+
+			metrics.UntrackWorkerInFlight("worker-1")
+
+			Expect(gatherText(metrics.NewRegistry(noWorkers))).NotTo(ContainSubstring(`worker_id="worker-1"`))
+		})
+	})
+
+	Context("gRPC interceptors", func() {
+		It("should count unary calls by service, method and status code", func() {
+			info := &grpc.UnaryServerInfo{FullMethod: "/nitric.proto.storage.v1.Storage/Read"}
+			notFound := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.NotFound, "blob not found")
+			}
+
+			_, err := metrics.UnaryServerInterceptor(context.TODO(), nil, info, notFound)
+			Expect(err).Should(HaveOccurred())
+
+			counter := metrics.GrpcRequests.WithLabelValues("nitric.proto.storage.v1.Storage", "Read", codes.NotFound.String())
+			Expect(testutil.ToFloat64(counter)).To(Equal(1.0))
+		})
+
+		It("should count streaming calls once the stream ends", func() {
+			info := &grpc.StreamServerInfo{FullMethod: "/nitric.proto.topics.v1.Subscriber/Subscribe"}
+			closed := func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			}
+
+			Expect(metrics.StreamServerInterceptor(nil, nil, info, closed)).To(Succeed())
+
+			counter := metrics.GrpcRequests.WithLabelValues("nitric.proto.topics.v1.Subscriber", "Subscribe", codes.OK.String())
+			Expect(testutil.ToFloat64(counter)).To(Equal(1.0))
+		})
+	})
+})
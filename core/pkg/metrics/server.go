@@ -0,0 +1,85 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// MetricsPath is the path the metrics endpoint is served on
+const MetricsPath = "/metrics"
+
+// MetricsServer publishes the nitric server metrics in the Prometheus and OpenMetrics exposition formats
+type MetricsServer struct {
+	server *http.Server
+}
+
+// Start serving metrics, blocking until the server is stopped
+func (m *MetricsServer) Start() error {
+	logger.Infof("Metrics listening on %s%s", m.server.Addr, MetricsPath)
+
+	err := m.server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Stop serving metrics
+func (m *MetricsServer) Stop() error {
+	return m.server.Close()
+}
+
+// NewRegistry creates a registry for the nitric server metrics, including the number of workers reported by registeredWorkers
+func NewRegistry(registeredWorkers RegisteredWorkersFunc) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		NewRegisteredWorkersCollector(registeredWorkers),
+		WorkerRequestDuration,
+		workerInFlight,
+		GrpcRequests,
+		ProcessRestarts,
+	)
+
+	return registry
+}
+
+// NewMetricsServer creates a metrics server listening on address
+func NewMetricsServer(address string, registeredWorkers RegisteredWorkersFunc) *MetricsServer {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(NewRegistry(registeredWorkers), promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+	}))
+
+	return &MetricsServer{
+		server: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+}
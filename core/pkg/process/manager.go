@@ -18,20 +18,71 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	"github.com/pkg/errors"
 )
 
+// RestartPolicy determines whether a process is restarted when it exits
+type RestartPolicy string
+
+const (
+	// RestartNever leaves exited processes stopped
+	RestartNever RestartPolicy = "never"
+	// RestartOnFailure restarts processes that exit with an error
+	RestartOnFailure RestartPolicy = "on-failure"
+	// RestartAlways restarts processes whenever they exit
+	RestartAlways RestartPolicy = "always"
+)
+
+const (
+	// time to wait before the first restart, doubled for each consecutive restart
+	initialRestartBackoff = time.Second
+	// longest time to wait between restarts, processes that run for longer than this reset the backoff
+	maxRestartBackoff = 30 * time.Second
+	// default time processes have to exit after SIGTERM before they're killed
+	defaultGracePeriod = 10 * time.Second
+)
+
+// RestartPolicyFromString returns the named restart policy, defaulting to RestartNever
+func RestartPolicyFromString(policy string) RestartPolicy {
+	switch p := RestartPolicy(strings.ToLower(policy)); p {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return p
+	case "":
+		return RestartNever
+	default:
+		logger.Warnf("unknown restart policy %q, defaulting to %s", policy, RestartNever)
+		return RestartNever
+	}
+}
+
 type process struct {
-	Command []string
-	cmd     *exec.Cmd
+	Command       []string
+	RestartPolicy RestartPolicy
+
+	env       []string
+	cmd       *exec.Cmd
+	startedAt time.Time
+	exited    chan struct{}
+	exitErr   error
+	// set once the process has exited and won't be restarted
+	finished bool
+	lock     sync.Mutex
 }
 
 type pMgr struct {
 	preProcesses   []*process
 	userProcess    *process
+	gracePeriod    time.Duration
+	stopping       atomic.Bool
+	stopped        chan struct{}
 	monitorErrChan chan error
 }
 
@@ -40,17 +91,50 @@ type ProcessManager interface {
 	StartUserProcess(...string) error
 	Monitor() error
 	StopAll()
+	// Alive returns an error if a supervised process has exited and won't be restarted
+	Alive() error
 }
 
-func NewProcessManager(userCommand []string, preCommands [][]string) ProcessManager {
+type ProcessManagerOption func(*pMgr)
+
+// WithUserRestartPolicy - Set the restart policy of the user process
+func WithUserRestartPolicy(policy RestartPolicy) ProcessManagerOption {
+	return func(m *pMgr) {
+		m.userProcess.RestartPolicy = policy
+	}
+}
+
+// WithPreCommandRestartPolicy - Set the restart policy of the processes started before the user process
+func WithPreCommandRestartPolicy(policy RestartPolicy) ProcessManagerOption {
+	return func(m *pMgr) {
+		for _, p := range m.preProcesses {
+			p.RestartPolicy = policy
+		}
+	}
+}
+
+// WithGracePeriod - Set the time processes have to exit after SIGTERM before they're killed
+func WithGracePeriod(gracePeriod time.Duration) ProcessManagerOption {
+	return func(m *pMgr) {
+		m.gracePeriod = gracePeriod
+	}
+}
+
+func NewProcessManager(userCommand []string, preCommands [][]string, opts ...ProcessManagerOption) ProcessManager {
 	m := &pMgr{
-		userProcess:    &process{Command: userCommand},
+		userProcess:    &process{Command: userCommand, RestartPolicy: RestartNever},
 		preProcesses:   []*process{},
-		monitorErrChan: make(chan error),
+		gracePeriod:    defaultGracePeriod,
+		stopped:        make(chan struct{}),
+		monitorErrChan: make(chan error, len(preCommands)+1),
 	}
 
 	for _, p := range preCommands {
-		m.preProcesses = append(m.preProcesses, &process{Command: p})
+		m.preProcesses = append(m.preProcesses, &process{Command: p, RestartPolicy: RestartNever})
+	}
+
+	for _, opt := range opts {
+		opt(m)
 	}
 
 	return m
@@ -70,34 +154,139 @@ func (pm *pMgr) StartPreProcesses(env ...string) error {
 	return nil
 }
 
+// StopAll stops the user process and then the pre processes, waiting for each to exit.
+// Processes that don't exit within the grace period after SIGTERM are killed.
 func (pm *pMgr) StopAll() {
-	err := pm.userProcess.stop()
+	if pm.stopping.Swap(true) {
+		return
+	}
+	close(pm.stopped)
+
+	err := pm.userProcess.stop(pm.gracePeriod)
 	if err != nil {
 		fmt.Println(err)
 	}
 
 	for _, p := range pm.preProcesses {
-		err := p.stop()
+		err := p.stop(pm.gracePeriod)
 		if err != nil {
 			fmt.Println(err)
 		}
 	}
 }
 
+// Monitor supervises the started processes, restarting them according to their restart policy.
+// Returns the exit error of the first process to exit that isn't restarted.
 func (pm *pMgr) Monitor() error {
 	for _, p := range append(pm.preProcesses, pm.userProcess) {
-		if p.cmd == nil {
+		if !p.started() {
 			continue
 		}
 
-		go func(c *exec.Cmd) {
-			pm.monitorErrChan <- c.Wait()
-		}(p.cmd)
+		go func(p *process) {
+			pm.monitorErrChan <- pm.supervise(p)
+		}(p)
 	}
 
 	return <-pm.monitorErrChan
 }
 
+func (pm *pMgr) Alive() error {
+	for _, p := range append(pm.preProcesses, pm.userProcess) {
+		if err := p.alive(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// supervise waits for a process to exit, restarting it until its restart policy no longer allows it
+func (pm *pMgr) supervise(p *process) error {
+	backoff := initialRestartBackoff
+
+	for {
+		ranFor, exitErr := p.wait()
+
+		if pm.stopping.Load() || !p.shouldRestart(exitErr) {
+			p.finish()
+			return exitErr
+		}
+
+		// processes that ran for a while before exiting aren't crash looping
+		if ranFor > maxRestartBackoff {
+			backoff = initialRestartBackoff
+		}
+
+		logger.Warnf("%s exited (%v), restarting in %s", p.Command[0], exitErr, backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-pm.stopped:
+			return exitErr
+		}
+
+		if err := p.start(p.env...); err != nil {
+			p.finish()
+			return err
+		}
+
+		metrics.ProcessRestarts.WithLabelValues(p.Command[0]).Inc()
+
+		backoff = min(backoff*2, maxRestartBackoff)
+	}
+}
+
+func (p *process) shouldRestart(exitErr error) bool {
+	switch p.RestartPolicy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return exitErr != nil
+	default:
+		return false
+	}
+}
+
+func (p *process) started() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.cmd != nil
+}
+
+func (p *process) finish() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.finished = true
+}
+
+func (p *process) alive() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.finished {
+		return fmt.Errorf("%s exited: %v", p.Command[0], p.exitErr)
+	}
+
+	return nil
+}
+
+// wait for the process to exit, returning how long it ran for and its exit error
+func (p *process) wait() (time.Duration, error) {
+	p.lock.Lock()
+	exited, startedAt := p.exited, p.startedAt
+	p.lock.Unlock()
+
+	<-exited
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return time.Since(startedAt), p.exitErr
+}
+
 func (p *process) start(env ...string) error {
 	if len(p.Command) == 0 {
 		logger.Debug("No Command Specified, Skipping...")
@@ -108,22 +297,61 @@ func (p *process) start(env ...string) error {
 	allEnv := append([]string{}, os.Environ()...)
 	allEnv = append(allEnv, env...)
 
-	p.cmd = exec.Command(p.Command[0], p.Command[1:]...) //#nosec G204 -- This is by design inputs are determined at compile time
-	p.cmd.Stdout = os.Stdout
-	p.cmd.Stderr = os.Stderr
-	p.cmd.Env = allEnv
+	cmd := exec.Command(p.Command[0], p.Command[1:]...) //#nosec G204 -- This is by design inputs are determined at compile time
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = allEnv
 
 	logger.Debugf("Starting: %s", p.Command[0])
 
-	return errors.WithMessagef(p.cmd.Start(), "there was an error starting the process %s", p.Command[0])
+	if err := cmd.Start(); err != nil {
+		return errors.WithMessagef(err, "there was an error starting the process %s", p.Command[0])
+	}
+
+	exited := make(chan struct{})
+
+	p.lock.Lock()
+	p.env = env
+	p.cmd = cmd
+	p.startedAt = time.Now()
+	p.exited = exited
+	p.exitErr = nil
+	p.lock.Unlock()
+
+	go func() {
+		err := cmd.Wait()
+
+		p.lock.Lock()
+		p.exitErr = err
+		p.lock.Unlock()
+
+		close(exited)
+	}()
+
+	return nil
 }
 
-func (p *process) stop() error {
-	if p == nil || p.cmd == nil {
+// stop sends SIGTERM to the process, killing it if it hasn't exited once the grace period elapses
+func (p *process) stop(gracePeriod time.Duration) error {
+	if p == nil {
+		return nil
+	}
+
+	p.lock.Lock()
+	cmd, exited := p.cmd, p.exited
+	p.lock.Unlock()
+
+	if cmd == nil {
 		return nil
 	}
 
-	err := p.cmd.Process.Signal(syscall.Signal(0))
+	select {
+	case <-exited:
+		return nil
+	default:
+	}
+
+	err := cmd.Process.Signal(syscall.SIGTERM)
 	if err != nil {
 		if errors.Is(err, os.ErrProcessDone) {
 			return nil
@@ -132,12 +360,20 @@ func (p *process) stop() error {
 		return err
 	}
 
-	err = p.cmd.Process.Signal(syscall.SIGTERM)
-	if err != nil {
-		if !errors.Is(err, os.ErrProcessDone) {
-			return err
-		}
+	select {
+	case <-exited:
+		return nil
+	case <-time.After(gracePeriod):
 	}
 
+	logger.Warnf("%s did not exit within %s of SIGTERM, killing it", p.Command[0], gracePeriod)
+
+	err = cmd.Process.Kill()
+	if err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+
+	<-exited
+
 	return nil
 }
@@ -0,0 +1,128 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pm "github.com/nitrictech/nitric/core/pkg/process"
+)
+
+var _ = Describe("Process Manager", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "process-manager")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Context("RestartPolicyFromString", func() {
+		It("should parse known policies", func() {
+			Expect(pm.RestartPolicyFromString("always")).To(Equal(pm.RestartAlways))
+			Expect(pm.RestartPolicyFromString("ON-FAILURE")).To(Equal(pm.RestartOnFailure))
+			Expect(pm.RestartPolicyFromString("never")).To(Equal(pm.RestartNever))
+		})
+
+		It("should default to never", func() {
+			Expect(pm.RestartPolicyFromString("")).To(Equal(pm.RestartNever))
+			Expect(pm.RestartPolicyFromString("sometimes")).To(Equal(pm.RestartNever))
+		})
+	})
+
+	When("the user process fails with the never restart policy", func() {
+		It("should return the exit error from Monitor", func() {
+			mgr := pm.NewProcessManager([]string{"sh", "-c", "exit 3"}, nil)
+			Expect(mgr.StartUserProcess()).To(Succeed())
+
+			err := mgr.Monitor()
+
+			var exitErr *exec.ExitError
+			Expect(err).To(BeAssignableToTypeOf(exitErr))
+			Expect(err.(*exec.ExitError).ExitCode()).To(Equal(3))
+		})
+	})
+
+	When("the user process fails with the on-failure restart policy", func() {
+		It("should restart it until it succeeds", func() {
+			runs := filepath.Join(tmpDir, "runs")
+			// fails on the first run and succeeds on the second
+			script := "echo run >> " + runs + " && [ $(wc -l < " + runs + ") -ge 2 ]"
+
+			mgr := pm.NewProcessManager([]string{"sh", "-c", script}, nil,
+				pm.WithUserRestartPolicy(pm.RestartOnFailure),
+			)
+			Expect(mgr.StartUserProcess()).To(Succeed())
+
+			Expect(mgr.Monitor()).To(Succeed())
+
+			contents, err := os.ReadFile(runs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("run\nrun\n"))
+		})
+	})
+
+	When("stopping a process that ignores SIGTERM", func() {
+		It("should kill it after the grace period", func() {
+			ready := filepath.Join(tmpDir, "ready")
+
+			mgr := pm.NewProcessManager([]string{"sh", "-c", "trap '' TERM; touch " + ready + "; while true; do sleep 0.1; done"}, nil,
+				pm.WithGracePeriod(200*time.Millisecond),
+			)
+			Expect(mgr.StartUserProcess()).To(Succeed())
+
+			Eventually(func() error {
+				_, err := os.Stat(ready)
+				return err
+			}, 5*time.Second).Should(Succeed())
+
+			done := make(chan struct{})
+			go func() {
+				mgr.StopAll()
+				close(done)
+			}()
+
+			Eventually(done, 5*time.Second).Should(BeClosed())
+		})
+	})
+
+	When("stopping a supervised process with the always restart policy", func() {
+		It("should not restart it", func() {
+			mgr := pm.NewProcessManager([]string{"sleep", "30"}, nil,
+				pm.WithUserRestartPolicy(pm.RestartAlways),
+			)
+			Expect(mgr.StartUserProcess()).To(Succeed())
+
+			monitorErr := make(chan error, 1)
+			go func() {
+				monitorErr <- mgr.Monitor()
+			}()
+
+			mgr.StopAll()
+
+			Eventually(monitorErr, 5*time.Second).Should(Receive(HaveOccurred()))
+		})
+	})
+})
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.4
+// source: nitric/proto/introspection/v1/introspection.proto
+
+package introspectionpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorkerType int32
+
+const (
+	WorkerType_Api              WorkerType = 0
+	WorkerType_Http             WorkerType = 1
+	WorkerType_Schedule         WorkerType = 2
+	WorkerType_Subscription     WorkerType = 3
+	WorkerType_BucketListener   WorkerType = 4
+	WorkerType_WebsocketHandler WorkerType = 5
+	WorkerType_Job              WorkerType = 6
+)
+
+// Enum value maps for WorkerType.
+var (
+	WorkerType_name = map[int32]string{
+		0: "Api",
+		1: "Http",
+		2: "Schedule",
+		3: "Subscription",
+		4: "BucketListener",
+		5: "WebsocketHandler",
+		6: "Job",
+	}
+	WorkerType_value = map[string]int32{
+		"Api":              0,
+		"Http":             1,
+		"Schedule":         2,
+		"Subscription":     3,
+		"BucketListener":   4,
+		"WebsocketHandler": 5,
+		"Job":              6,
+	}
+)
+
+func (x WorkerType) Enum() *WorkerType {
+	p := new(WorkerType)
+	*p = x
+	return p
+}
+
+func (x WorkerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nitric_proto_introspection_v1_introspection_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkerType) Type() protoreflect.EnumType {
+	return &file_nitric_proto_introspection_v1_introspection_proto_enumTypes[0]
+}
+
+func (x WorkerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerType.Descriptor instead.
+func (WorkerType) EnumDescriptor() ([]byte, []int) {
+	return file_nitric_proto_introspection_v1_introspection_proto_rawDescGZIP(), []int{0}
+}
+
+// Details of the workers registered for a single trigger
+type RegisteredWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type WorkerType `protobuf:"varint,1,opt,name=type,proto3,enum=nitric.proto.introspection.v1.WorkerType" json:"type,omitempty"`
+	// The name of the resource the workers are registered for, e.g. the api, topic, bucket, schedule, socket or job name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The trigger handled by the workers, e.g. "GET,POST /users/:id" for an api route, or "Created files/" for a bucket listener
+	Trigger string `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// The number of workers registered for the trigger
+	WorkerCount int32 `protobuf:"varint,4,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`
+}
+
+func (x *RegisteredWorker) Reset() {
+	*x = RegisteredWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredWorker) ProtoMessage() {}
+
+func (x *RegisteredWorker) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredWorker.ProtoReflect.Descriptor instead.
+func (*RegisteredWorker) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_introspection_v1_introspection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisteredWorker) GetType() WorkerType {
+	if x != nil {
+		return x.Type
+	}
+	return WorkerType_Api
+}
+
+func (x *RegisteredWorker) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisteredWorker) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *RegisteredWorker) GetWorkerCount() int32 {
+	if x != nil {
+		return x.WorkerCount
+	}
+	return 0
+}
+
+type IntrospectionListWorkersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IntrospectionListWorkersRequest) Reset() {
+	*x = IntrospectionListWorkersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntrospectionListWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectionListWorkersRequest) ProtoMessage() {}
+
+func (x *IntrospectionListWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectionListWorkersRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectionListWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_introspection_v1_introspection_proto_rawDescGZIP(), []int{1}
+}
+
+type IntrospectionListWorkersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The registered workers, across all worker types
+	Workers []*RegisteredWorker `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+}
+
+func (x *IntrospectionListWorkersResponse) Reset() {
+	*x = IntrospectionListWorkersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntrospectionListWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectionListWorkersResponse) ProtoMessage() {}
+
+func (x *IntrospectionListWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_introspection_v1_introspection_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectionListWorkersResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectionListWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_introspection_v1_introspection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IntrospectionListWorkersResponse) GetWorkers() []*RegisteredWorker {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+var File_nitric_proto_introspection_v1_introspection_proto protoreflect.FileDescriptor
+
+var file_nitric_proto_introspection_v1_introspection_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69,
+	0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x20, 0x49, 0x6e,
+	0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2a, 0x72, 0x0a, 0x0a, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x70, 0x69, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x10, 0x04, 0x12, 0x14,
+	0x0a, 0x10, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x10, 0x06, 0x32, 0xa0, 0x01,
+	0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x8e, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x3e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0xc8, 0x01, 0x0a, 0x20, 0x69, 0x6f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x01, 0x5a, 0x4c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x65, 0x63, 0x68, 0x2f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0xaa, 0x02, 0x1d, 0x4e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x1d, 0x4e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x5c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nitric_proto_introspection_v1_introspection_proto_rawDescOnce sync.Once
+	file_nitric_proto_introspection_v1_introspection_proto_rawDescData = file_nitric_proto_introspection_v1_introspection_proto_rawDesc
+)
+
+func file_nitric_proto_introspection_v1_introspection_proto_rawDescGZIP() []byte {
+	file_nitric_proto_introspection_v1_introspection_proto_rawDescOnce.Do(func() {
+		file_nitric_proto_introspection_v1_introspection_proto_rawDescData = protoimpl.X.CompressGZIP(file_nitric_proto_introspection_v1_introspection_proto_rawDescData)
+	})
+	return file_nitric_proto_introspection_v1_introspection_proto_rawDescData
+}
+
+var file_nitric_proto_introspection_v1_introspection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_nitric_proto_introspection_v1_introspection_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_nitric_proto_introspection_v1_introspection_proto_goTypes = []interface{}{
+	(WorkerType)(0),                          // 0: nitric.proto.introspection.v1.WorkerType
+	(*RegisteredWorker)(nil),                 // 1: nitric.proto.introspection.v1.RegisteredWorker
+	(*IntrospectionListWorkersRequest)(nil),  // 2: nitric.proto.introspection.v1.IntrospectionListWorkersRequest
+	(*IntrospectionListWorkersResponse)(nil), // 3: nitric.proto.introspection.v1.IntrospectionListWorkersResponse
+}
+var file_nitric_proto_introspection_v1_introspection_proto_depIdxs = []int32{
+	0, // 0: nitric.proto.introspection.v1.RegisteredWorker.type:type_name -> nitric.proto.introspection.v1.WorkerType
+	1, // 1: nitric.proto.introspection.v1.IntrospectionListWorkersResponse.workers:type_name -> nitric.proto.introspection.v1.RegisteredWorker
+	2, // 2: nitric.proto.introspection.v1.Introspection.ListWorkers:input_type -> nitric.proto.introspection.v1.IntrospectionListWorkersRequest
+	3, // 3: nitric.proto.introspection.v1.Introspection.ListWorkers:output_type -> nitric.proto.introspection.v1.IntrospectionListWorkersResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_nitric_proto_introspection_v1_introspection_proto_init() }
+func file_nitric_proto_introspection_v1_introspection_proto_init() {
+	if File_nitric_proto_introspection_v1_introspection_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nitric_proto_introspection_v1_introspection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredWorker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nitric_proto_introspection_v1_introspection_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectionListWorkersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nitric_proto_introspection_v1_introspection_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectionListWorkersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nitric_proto_introspection_v1_introspection_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nitric_proto_introspection_v1_introspection_proto_goTypes,
+		DependencyIndexes: file_nitric_proto_introspection_v1_introspection_proto_depIdxs,
+		EnumInfos:         file_nitric_proto_introspection_v1_introspection_proto_enumTypes,
+		MessageInfos:      file_nitric_proto_introspection_v1_introspection_proto_msgTypes,
+	}.Build()
+	File_nitric_proto_introspection_v1_introspection_proto = out.File
+	file_nitric_proto_introspection_v1_introspection_proto_rawDesc = nil
+	file_nitric_proto_introspection_v1_introspection_proto_goTypes = nil
+	file_nitric_proto_introspection_v1_introspection_proto_depIdxs = nil
+}
@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.4
+// source: nitric/proto/introspection/v1/introspection.proto
+
+package introspectionpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// IntrospectionClient is the client API for Introspection service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IntrospectionClient interface {
+	// List the workers currently registered with the server
+	ListWorkers(ctx context.Context, in *IntrospectionListWorkersRequest, opts ...grpc.CallOption) (*IntrospectionListWorkersResponse, error)
+}
+
+type introspectionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIntrospectionClient(cc grpc.ClientConnInterface) IntrospectionClient {
+	return &introspectionClient{cc}
+}
+
+func (c *introspectionClient) ListWorkers(ctx context.Context, in *IntrospectionListWorkersRequest, opts ...grpc.CallOption) (*IntrospectionListWorkersResponse, error) {
+	out := new(IntrospectionListWorkersResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.introspection.v1.Introspection/ListWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IntrospectionServer is the server API for Introspection service.
+// All implementations should embed UnimplementedIntrospectionServer
+// for forward compatibility
+type IntrospectionServer interface {
+	// List the workers currently registered with the server
+	ListWorkers(context.Context, *IntrospectionListWorkersRequest) (*IntrospectionListWorkersResponse, error)
+}
+
+// UnimplementedIntrospectionServer should be embedded to have forward compatible implementations.
+type UnimplementedIntrospectionServer struct {
+}
+
+func (UnimplementedIntrospectionServer) ListWorkers(context.Context, *IntrospectionListWorkersRequest) (*IntrospectionListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+
+// UnsafeIntrospectionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IntrospectionServer will
+// result in compilation errors.
+type UnsafeIntrospectionServer interface {
+	mustEmbedUnimplementedIntrospectionServer()
+}
+
+func RegisterIntrospectionServer(s grpc.ServiceRegistrar, srv IntrospectionServer) {
+	s.RegisterService(&Introspection_ServiceDesc, srv)
+}
+
+func _Introspection_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectionListWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntrospectionServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.introspection.v1.Introspection/ListWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntrospectionServer).ListWorkers(ctx, req.(*IntrospectionListWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Introspection_ServiceDesc is the grpc.ServiceDesc for Introspection service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Introspection_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.proto.introspection.v1.Introspection",
+	HandlerType: (*IntrospectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWorkers",
+			Handler:    _Introspection_ListWorkers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nitric/proto/introspection/v1/introspection.proto",
+}
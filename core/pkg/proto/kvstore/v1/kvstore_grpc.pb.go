@@ -28,8 +28,14 @@ type KvStoreClient interface {
 	SetValue(ctx context.Context, in *KvStoreSetValueRequest, opts ...grpc.CallOption) (*KvStoreSetValueResponse, error)
 	// Delete a key and its value
 	DeleteKey(ctx context.Context, in *KvStoreDeleteKeyRequest, opts ...grpc.CallOption) (*KvStoreDeleteKeyResponse, error)
-	// Iterate over all keys in a store
+	// Iterate over the keys in a store, optionally a page at a time
 	ScanKeys(ctx context.Context, in *KvStoreScanKeysRequest, opts ...grpc.CallOption) (KvStore_ScanKeysClient, error)
+	// Get multiple existing values
+	GetValues(ctx context.Context, in *KvStoreGetValuesRequest, opts ...grpc.CallOption) (*KvStoreGetValuesResponse, error)
+	// Create new or overwrite multiple existing values
+	SetValues(ctx context.Context, in *KvStoreSetValuesRequest, opts ...grpc.CallOption) (*KvStoreSetValuesResponse, error)
+	// Delete multiple keys and their values
+	DeleteKeys(ctx context.Context, in *KvStoreDeleteKeysRequest, opts ...grpc.CallOption) (*KvStoreDeleteKeysResponse, error)
 }
 
 type kvStoreClient struct {
@@ -99,6 +105,33 @@ func (x *kvStoreScanKeysClient) Recv() (*KvStoreScanKeysResponse, error) {
 	return m, nil
 }
 
+func (c *kvStoreClient) GetValues(ctx context.Context, in *KvStoreGetValuesRequest, opts ...grpc.CallOption) (*KvStoreGetValuesResponse, error) {
+	out := new(KvStoreGetValuesResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.kvstore.v1.KvStore/GetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kvStoreClient) SetValues(ctx context.Context, in *KvStoreSetValuesRequest, opts ...grpc.CallOption) (*KvStoreSetValuesResponse, error) {
+	out := new(KvStoreSetValuesResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.kvstore.v1.KvStore/SetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kvStoreClient) DeleteKeys(ctx context.Context, in *KvStoreDeleteKeysRequest, opts ...grpc.CallOption) (*KvStoreDeleteKeysResponse, error) {
+	out := new(KvStoreDeleteKeysResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.kvstore.v1.KvStore/DeleteKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KvStoreServer is the server API for KvStore service.
 // All implementations should embed UnimplementedKvStoreServer
 // for forward compatibility
@@ -109,8 +142,14 @@ type KvStoreServer interface {
 	SetValue(context.Context, *KvStoreSetValueRequest) (*KvStoreSetValueResponse, error)
 	// Delete a key and its value
 	DeleteKey(context.Context, *KvStoreDeleteKeyRequest) (*KvStoreDeleteKeyResponse, error)
-	// Iterate over all keys in a store
+	// Iterate over the keys in a store, optionally a page at a time
 	ScanKeys(*KvStoreScanKeysRequest, KvStore_ScanKeysServer) error
+	// Get multiple existing values
+	GetValues(context.Context, *KvStoreGetValuesRequest) (*KvStoreGetValuesResponse, error)
+	// Create new or overwrite multiple existing values
+	SetValues(context.Context, *KvStoreSetValuesRequest) (*KvStoreSetValuesResponse, error)
+	// Delete multiple keys and their values
+	DeleteKeys(context.Context, *KvStoreDeleteKeysRequest) (*KvStoreDeleteKeysResponse, error)
 }
 
 // UnimplementedKvStoreServer should be embedded to have forward compatible implementations.
@@ -129,6 +168,15 @@ func (UnimplementedKvStoreServer) DeleteKey(context.Context, *KvStoreDeleteKeyRe
 func (UnimplementedKvStoreServer) ScanKeys(*KvStoreScanKeysRequest, KvStore_ScanKeysServer) error {
 	return status.Errorf(codes.Unimplemented, "method ScanKeys not implemented")
 }
+func (UnimplementedKvStoreServer) GetValues(context.Context, *KvStoreGetValuesRequest) (*KvStoreGetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValues not implemented")
+}
+func (UnimplementedKvStoreServer) SetValues(context.Context, *KvStoreSetValuesRequest) (*KvStoreSetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValues not implemented")
+}
+func (UnimplementedKvStoreServer) DeleteKeys(context.Context, *KvStoreDeleteKeysRequest) (*KvStoreDeleteKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeys not implemented")
+}
 
 // UnsafeKvStoreServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KvStoreServer will
@@ -216,6 +264,60 @@ func (x *kvStoreScanKeysServer) Send(m *KvStoreScanKeysResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _KvStore_GetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KvStoreGetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KvStoreServer).GetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.kvstore.v1.KvStore/GetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KvStoreServer).GetValues(ctx, req.(*KvStoreGetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KvStore_SetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KvStoreSetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KvStoreServer).SetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.kvstore.v1.KvStore/SetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KvStoreServer).SetValues(ctx, req.(*KvStoreSetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KvStore_DeleteKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KvStoreDeleteKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KvStoreServer).DeleteKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.kvstore.v1.KvStore/DeleteKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KvStoreServer).DeleteKeys(ctx, req.(*KvStoreDeleteKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KvStore_ServiceDesc is the grpc.ServiceDesc for KvStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -235,6 +337,18 @@ var KvStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteKey",
 			Handler:    _KvStore_DeleteKey_Handler,
 		},
+		{
+			MethodName: "GetValues",
+			Handler:    _KvStore_GetValues_Handler,
+		},
+		{
+			MethodName: "SetValues",
+			Handler:    _KvStore_SetValues_Handler,
+		},
+		{
+			MethodName: "DeleteKeys",
+			Handler:    _KvStore_DeleteKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -26,6 +26,10 @@ type StorageClient interface {
 	Read(ctx context.Context, in *StorageReadRequest, opts ...grpc.CallOption) (*StorageReadResponse, error)
 	// Store an item to a bucket
 	Write(ctx context.Context, in *StorageWriteRequest, opts ...grpc.CallOption) (*StorageWriteResponse, error)
+	// Retrieve an item, or a byte range of an item, from a bucket as a stream of chunks
+	ReadStream(ctx context.Context, in *StorageReadStreamRequest, opts ...grpc.CallOption) (Storage_ReadStreamClient, error)
+	// Store an item to a bucket from a stream of chunks
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (Storage_WriteStreamClient, error)
 	// Delete an item from a bucket
 	Delete(ctx context.Context, in *StorageDeleteRequest, opts ...grpc.CallOption) (*StorageDeleteResponse, error)
 	// Generate a pre-signed URL for direct operations on an item
@@ -34,6 +38,14 @@ type StorageClient interface {
 	ListBlobs(ctx context.Context, in *StorageListBlobsRequest, opts ...grpc.CallOption) (*StorageListBlobsResponse, error)
 	// Determine is an object exists in a bucket
 	Exists(ctx context.Context, in *StorageExistsRequest, opts ...grpc.CallOption) (*StorageExistsResponse, error)
+	// Retrieve the attributes of an item in a bucket, without its body
+	Stat(ctx context.Context, in *StorageStatRequest, opts ...grpc.CallOption) (*StorageStatResponse, error)
+	// Copy an item to another key, in the same or another bucket, without reading it through the client.
+	// Requires BucketFileGet on the source bucket and BucketFilePut on the destination bucket.
+	Copy(ctx context.Context, in *StorageCopyRequest, opts ...grpc.CallOption) (*StorageCopyResponse, error)
+	// Move an item to another key, in the same or another bucket, by copying it and deleting the source.
+	// Requires BucketFileGet and BucketFileDelete on the source bucket and BucketFilePut on the destination bucket.
+	Move(ctx context.Context, in *StorageMoveRequest, opts ...grpc.CallOption) (*StorageMoveResponse, error)
 }
 
 type storageClient struct {
@@ -62,6 +74,72 @@ func (c *storageClient) Write(ctx context.Context, in *StorageWriteRequest, opts
 	return out, nil
 }
 
+func (c *storageClient) ReadStream(ctx context.Context, in *StorageReadStreamRequest, opts ...grpc.CallOption) (Storage_ReadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[0], "/nitric.proto.storage.v1.Storage/ReadStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageReadStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_ReadStreamClient interface {
+	Recv() (*StorageReadStreamResponse, error)
+	grpc.ClientStream
+}
+
+type storageReadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageReadStreamClient) Recv() (*StorageReadStreamResponse, error) {
+	m := new(StorageReadStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (Storage_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[1], "/nitric.proto.storage.v1.Storage/WriteStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageWriteStreamClient{stream}
+	return x, nil
+}
+
+type Storage_WriteStreamClient interface {
+	Send(*StorageWriteStreamRequest) error
+	CloseAndRecv() (*StorageWriteResponse, error)
+	grpc.ClientStream
+}
+
+type storageWriteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageWriteStreamClient) Send(m *StorageWriteStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storageWriteStreamClient) CloseAndRecv() (*StorageWriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StorageWriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *storageClient) Delete(ctx context.Context, in *StorageDeleteRequest, opts ...grpc.CallOption) (*StorageDeleteResponse, error) {
 	out := new(StorageDeleteResponse)
 	err := c.cc.Invoke(ctx, "/nitric.proto.storage.v1.Storage/Delete", in, out, opts...)
@@ -98,6 +176,33 @@ func (c *storageClient) Exists(ctx context.Context, in *StorageExistsRequest, op
 	return out, nil
 }
 
+func (c *storageClient) Stat(ctx context.Context, in *StorageStatRequest, opts ...grpc.CallOption) (*StorageStatResponse, error) {
+	out := new(StorageStatResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.storage.v1.Storage/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) Copy(ctx context.Context, in *StorageCopyRequest, opts ...grpc.CallOption) (*StorageCopyResponse, error) {
+	out := new(StorageCopyResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.storage.v1.Storage/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) Move(ctx context.Context, in *StorageMoveRequest, opts ...grpc.CallOption) (*StorageMoveResponse, error) {
+	out := new(StorageMoveResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.storage.v1.Storage/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServer is the server API for Storage service.
 // All implementations should embed UnimplementedStorageServer
 // for forward compatibility
@@ -106,6 +211,10 @@ type StorageServer interface {
 	Read(context.Context, *StorageReadRequest) (*StorageReadResponse, error)
 	// Store an item to a bucket
 	Write(context.Context, *StorageWriteRequest) (*StorageWriteResponse, error)
+	// Retrieve an item, or a byte range of an item, from a bucket as a stream of chunks
+	ReadStream(*StorageReadStreamRequest, Storage_ReadStreamServer) error
+	// Store an item to a bucket from a stream of chunks
+	WriteStream(Storage_WriteStreamServer) error
 	// Delete an item from a bucket
 	Delete(context.Context, *StorageDeleteRequest) (*StorageDeleteResponse, error)
 	// Generate a pre-signed URL for direct operations on an item
@@ -114,6 +223,14 @@ type StorageServer interface {
 	ListBlobs(context.Context, *StorageListBlobsRequest) (*StorageListBlobsResponse, error)
 	// Determine is an object exists in a bucket
 	Exists(context.Context, *StorageExistsRequest) (*StorageExistsResponse, error)
+	// Retrieve the attributes of an item in a bucket, without its body
+	Stat(context.Context, *StorageStatRequest) (*StorageStatResponse, error)
+	// Copy an item to another key, in the same or another bucket, without reading it through the client.
+	// Requires BucketFileGet on the source bucket and BucketFilePut on the destination bucket.
+	Copy(context.Context, *StorageCopyRequest) (*StorageCopyResponse, error)
+	// Move an item to another key, in the same or another bucket, by copying it and deleting the source.
+	// Requires BucketFileGet and BucketFileDelete on the source bucket and BucketFilePut on the destination bucket.
+	Move(context.Context, *StorageMoveRequest) (*StorageMoveResponse, error)
 }
 
 // UnimplementedStorageServer should be embedded to have forward compatible implementations.
@@ -126,6 +243,12 @@ func (UnimplementedStorageServer) Read(context.Context, *StorageReadRequest) (*S
 func (UnimplementedStorageServer) Write(context.Context, *StorageWriteRequest) (*StorageWriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
+func (UnimplementedStorageServer) ReadStream(*StorageReadStreamRequest, Storage_ReadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+}
+func (UnimplementedStorageServer) WriteStream(Storage_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
+}
 func (UnimplementedStorageServer) Delete(context.Context, *StorageDeleteRequest) (*StorageDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -138,6 +261,15 @@ func (UnimplementedStorageServer) ListBlobs(context.Context, *StorageListBlobsRe
 func (UnimplementedStorageServer) Exists(context.Context, *StorageExistsRequest) (*StorageExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
 }
+func (UnimplementedStorageServer) Stat(context.Context, *StorageStatRequest) (*StorageStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (UnimplementedStorageServer) Copy(context.Context, *StorageCopyRequest) (*StorageCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (UnimplementedStorageServer) Move(context.Context, *StorageMoveRequest) (*StorageMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
 
 // UnsafeStorageServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StorageServer will
@@ -186,6 +318,53 @@ func _Storage_Write_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Storage_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageReadStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).ReadStream(m, &storageReadStreamServer{stream})
+}
+
+type Storage_ReadStreamServer interface {
+	Send(*StorageReadStreamResponse) error
+	grpc.ServerStream
+}
+
+type storageReadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageReadStreamServer) Send(m *StorageReadStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StorageServer).WriteStream(&storageWriteStreamServer{stream})
+}
+
+type Storage_WriteStreamServer interface {
+	SendAndClose(*StorageWriteResponse) error
+	Recv() (*StorageWriteStreamRequest, error)
+	grpc.ServerStream
+}
+
+type storageWriteStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageWriteStreamServer) SendAndClose(m *StorageWriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storageWriteStreamServer) Recv() (*StorageWriteStreamRequest, error) {
+	m := new(StorageWriteStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Storage_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageDeleteRequest)
 	if err := dec(in); err != nil {
@@ -258,6 +437,60 @@ func _Storage_Exists_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Storage_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageStatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.storage.v1.Storage/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).Stat(ctx, req.(*StorageStatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.storage.v1.Storage/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).Copy(ctx, req.(*StorageCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.storage.v1.Storage/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).Move(ctx, req.(*StorageMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Storage_ServiceDesc is the grpc.ServiceDesc for Storage service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,8 +522,31 @@ var Storage_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exists",
 			Handler:    _Storage_Exists_Handler,
 		},
+		{
+			MethodName: "Stat",
+			Handler:    _Storage_Stat_Handler,
+		},
+		{
+			MethodName: "Copy",
+			Handler:    _Storage_Copy_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _Storage_Move_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadStream",
+			Handler:       _Storage_ReadStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteStream",
+			Handler:       _Storage_WriteStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "nitric/proto/storage/v1/storage.proto",
 }
 
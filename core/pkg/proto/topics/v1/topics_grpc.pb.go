@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type TopicsClient interface {
 	// Publishes a message to a given topic
 	Publish(ctx context.Context, in *TopicPublishRequest, opts ...grpc.CallOption) (*TopicPublishResponse, error)
+	// Publishes multiple messages to a given topic
+	PublishBatch(ctx context.Context, in *TopicPublishBatchRequest, opts ...grpc.CallOption) (*TopicPublishBatchResponse, error)
 }
 
 type topicsClient struct {
@@ -43,12 +45,23 @@ func (c *topicsClient) Publish(ctx context.Context, in *TopicPublishRequest, opt
 	return out, nil
 }
 
+func (c *topicsClient) PublishBatch(ctx context.Context, in *TopicPublishBatchRequest, opts ...grpc.CallOption) (*TopicPublishBatchResponse, error) {
+	out := new(TopicPublishBatchResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.topics.v1.Topics/PublishBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TopicsServer is the server API for Topics service.
 // All implementations should embed UnimplementedTopicsServer
 // for forward compatibility
 type TopicsServer interface {
 	// Publishes a message to a given topic
 	Publish(context.Context, *TopicPublishRequest) (*TopicPublishResponse, error)
+	// Publishes multiple messages to a given topic
+	PublishBatch(context.Context, *TopicPublishBatchRequest) (*TopicPublishBatchResponse, error)
 }
 
 // UnimplementedTopicsServer should be embedded to have forward compatible implementations.
@@ -58,6 +71,9 @@ type UnimplementedTopicsServer struct {
 func (UnimplementedTopicsServer) Publish(context.Context, *TopicPublishRequest) (*TopicPublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
 }
+func (UnimplementedTopicsServer) PublishBatch(context.Context, *TopicPublishBatchRequest) (*TopicPublishBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBatch not implemented")
+}
 
 // UnsafeTopicsServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TopicsServer will
@@ -88,6 +104,24 @@ func _Topics_Publish_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Topics_PublishBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicPublishBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopicsServer).PublishBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.topics.v1.Topics/PublishBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopicsServer).PublishBatch(ctx, req.(*TopicPublishBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Topics_ServiceDesc is the grpc.ServiceDesc for Topics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +133,10 @@ var Topics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Publish",
 			Handler:    _Topics_Publish_Handler,
 		},
+		{
+			MethodName: "PublishBatch",
+			Handler:    _Topics_PublishBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nitric/proto/topics/v1/topics.proto",
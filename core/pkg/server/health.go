@@ -0,0 +1,107 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// The paths the health endpoints are served on
+const (
+	HealthPath    = "/healthz"
+	ReadinessPath = "/readyz"
+	LivenessPath  = "/livez"
+)
+
+// HealthCheck is a named check, returning an error when the component it checks is unhealthy
+type HealthCheck struct {
+	Name  string
+	Check func() error
+}
+
+// HealthServer serves the health, readiness and liveness endpoints of the nitric server
+type HealthServer struct {
+	server *http.Server
+}
+
+// Start serving the health endpoints, blocking until the server is stopped
+func (h *HealthServer) Start() error {
+	logger.Infof("Health checks listening on %s", h.server.Addr)
+
+	err := h.server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Stop serving the health endpoints
+func (h *HealthServer) Stop() error {
+	return h.server.Close()
+}
+
+// Handler returns the handler serving the health endpoints
+func (h *HealthServer) Handler() http.Handler {
+	return h.server.Handler
+}
+
+// healthHandler responds with 200 when all checks pass and 503 otherwise, listing the result of each check
+func healthHandler(checks []HealthCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		results := make([]string, 0, len(checks))
+
+		for _, check := range checks {
+			if err := check.Check(); err != nil {
+				status = http.StatusServiceUnavailable
+				results = append(results, fmt.Sprintf("[-]%s failed: %v", check.Name, err))
+				continue
+			}
+
+			results = append(results, fmt.Sprintf("[+]%s ok", check.Name))
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintln(w, strings.Join(results, "\n"))
+	}
+}
+
+// NewHealthServer creates a health server listening on address.
+// The liveness endpoint runs the liveness checks, the readiness endpoint runs the readiness and liveness checks,
+// and the health endpoint reports the overall health of the server, which is the same as its readiness.
+func NewHealthServer(address string, liveness []HealthCheck, readiness []HealthCheck) *HealthServer {
+	allChecks := append(append([]HealthCheck{}, liveness...), readiness...)
+
+	mux := http.NewServeMux()
+	mux.Handle(LivenessPath, healthHandler(liveness))
+	mux.Handle(ReadinessPath, healthHandler(allChecks))
+	mux.Handle(HealthPath, healthHandler(allChecks))
+
+	return &HealthServer{
+		server: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	"github.com/golang/mock/gomock"
+	mock_gateway "github.com/nitrictech/nitric/core/mocks/gateway"
+	server "github.com/nitrictech/nitric/core/pkg/server"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getHealth(handler http.Handler, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	body, _ := io.ReadAll(rec.Body)
+	return rec.Code, string(body)
+}
+
+var _ = Describe("Health Server", func() {
+	passing := server.HealthCheck{Name: "passing", Check: func() error { return nil }}
+	failing := server.HealthCheck{Name: "failing", Check: func() error { return errors.New("broken") }}
+
+	When("all checks pass", func() {
+		It("should respond with 200", func() {
+			handler := server.NewHealthServer("", []server.HealthCheck{passing}, []server.HealthCheck{passing}).Handler()
+
+			for _, path := range []string{server.HealthPath, server.ReadinessPath, server.LivenessPath} {
+				code, body := getHealth(handler, path)
+				Expect(code).To(Equal(http.StatusOK))
+				Expect(body).To(ContainSubstring("[+]passing ok"))
+			}
+		})
+	})
+
+	When("a readiness check fails", func() {
+		It("should respond with 503 from the readiness and health endpoints only", func() {
+			handler := server.NewHealthServer("", []server.HealthCheck{passing}, []server.HealthCheck{failing}).Handler()
+
+			code, body := getHealth(handler, server.ReadinessPath)
+			Expect(code).To(Equal(http.StatusServiceUnavailable))
+			Expect(body).To(ContainSubstring("[+]passing ok"))
+			Expect(body).To(ContainSubstring("[-]failing failed: broken"))
+
+			code, _ = getHealth(handler, server.HealthPath)
+			Expect(code).To(Equal(http.StatusServiceUnavailable))
+
+			code, _ = getHealth(handler, server.LivenessPath)
+			Expect(code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("a liveness check fails", func() {
+		It("should respond with 503 from every endpoint", func() {
+			handler := server.NewHealthServer("", []server.HealthCheck{failing}, []server.HealthCheck{passing}).Handler()
+
+			for _, path := range []string{server.HealthPath, server.ReadinessPath, server.LivenessPath} {
+				code, _ := getHealth(handler, path)
+				Expect(code).To(Equal(http.StatusServiceUnavailable))
+			}
+		})
+	})
+
+	When("served by the nitric server", func() {
+		var srv *server.NitricServer
+		gatewayStopped := make(chan struct{})
+
+		BeforeEach(func() {
+			os.Args = []string{}
+
+			ctrl := gomock.NewController(GinkgoT())
+			mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+			mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().DoAndReturn(func(any) error {
+				<-gatewayStopped
+				return nil
+			})
+			mockGateway.EXPECT().Stop().AnyTimes().DoAndReturn(func() error {
+				close(gatewayStopped)
+				return nil
+			})
+
+			srv, _ = server.New(
+				server.WithMinWorkers(0),
+				server.WithGatewayPlugin(mockGateway),
+				server.WithHealthAddress("localhost:9007"),
+				server.WithDrainTimeoutSeconds(0),
+			)
+		})
+
+		It("should become ready once the gateway has started and unready when stopping", func() {
+			go func() {
+				_ = srv.Start()
+			}()
+
+			readyz := func() int {
+				resp, err := http.Get("http://localhost:9007" + server.ReadinessPath)
+				if err != nil {
+					return 0
+				}
+				defer resp.Body.Close()
+				return resp.StatusCode
+			}
+
+			Eventually(readyz, "5s").Should(Equal(http.StatusOK))
+
+			resp, err := http.Get("http://localhost:9007" + server.LivenessPath)
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			srv.Stop()
+
+			Eventually(readyz, "5s").ShouldNot(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// IntrospectionServer lists the workers registered with the worker plugins of a nitric server
+type IntrospectionServer struct {
+	plugins []any
+}
+
+var (
+	_ introspectionpb.IntrospectionServer = &IntrospectionServer{}
+	_ workers.Introspectable              = &IntrospectionServer{}
+)
+
+// RegisteredWorkers returns the workers registered with each plugin, plugins which can't describe their workers are skipped
+func (i *IntrospectionServer) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	registered := []*introspectionpb.RegisteredWorker{}
+
+	for _, plugin := range i.plugins {
+		if introspectable, ok := plugin.(workers.Introspectable); ok {
+			registered = append(registered, introspectable.RegisteredWorkers()...)
+		}
+	}
+
+	return registered
+}
+
+// ListWorkers returns the workers registered with the server
+func (i *IntrospectionServer) ListWorkers(ctx context.Context, req *introspectionpb.IntrospectionListWorkersRequest) (*introspectionpb.IntrospectionListWorkersResponse, error) {
+	return &introspectionpb.IntrospectionListWorkersResponse{
+		Workers: i.RegisteredWorkers(),
+	}, nil
+}
+
+// NewIntrospectionServer creates an introspection server for the given worker plugins
+func NewIntrospectionServer(plugins ...any) *IntrospectionServer {
+	return &IntrospectionServer{
+		plugins: plugins,
+	}
+}
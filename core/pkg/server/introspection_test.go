@@ -0,0 +1,71 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+	server "github.com/nitrictech/nitric/core/pkg/server"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type introspectablePlugin struct {
+	workers []*introspectionpb.RegisteredWorker
+}
+
+func (p *introspectablePlugin) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	return p.workers
+}
+
+var _ = Describe("Introspection Server", func() {
+	When("listing workers", func() {
+		It("should return the workers of each introspectable plugin", func() {
+			schedule := &introspectionpb.RegisteredWorker{
+				Type:        introspectionpb.WorkerType_Schedule,
+				Name:        "nightly",
+				Trigger:     "nightly",
+				WorkerCount: 2,
+			}
+			job := &introspectionpb.RegisteredWorker{
+				Type:        introspectionpb.WorkerType_Job,
+				Name:        "report",
+				Trigger:     "report",
+				WorkerCount: 1,
+			}
+
+			srv := server.NewIntrospectionServer(
+				&introspectablePlugin{workers: []*introspectionpb.RegisteredWorker{schedule}},
+				"not introspectable",
+				&introspectablePlugin{workers: []*introspectionpb.RegisteredWorker{job}},
+			)
+
+			resp, err := srv.ListWorkers(context.TODO(), &introspectionpb.IntrospectionListWorkersRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Workers).To(HaveLen(2))
+			Expect(resp.Workers[0]).To(BeIdenticalTo(schedule))
+			Expect(resp.Workers[1]).To(BeIdenticalTo(job))
+		})
+
+		It("should return an empty list when no workers are registered", func() {
+			srv := server.NewIntrospectionServer(&introspectablePlugin{})
+
+			resp, err := srv.ListWorkers(context.TODO(), &introspectionpb.IntrospectionListWorkersRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Workers).To(BeEmpty())
+		})
+	})
+})
@@ -16,6 +16,7 @@ package server
 
 import (
 	"github.com/nitrictech/nitric/core/pkg/gateway"
+	pm "github.com/nitrictech/nitric/core/pkg/process"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
@@ -138,6 +139,22 @@ func WithWebsocketListenerPlugin(websockets websockets.WebsocketRequestHandler)
 	}
 }
 
+// WithMetricsAddress - Serve Prometheus metrics on the given address.
+// metrics are disabled unless this option or the METRICS_ADDRESS environment variable is set
+func WithMetricsAddress(address string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.MetricsAddress = address
+	}
+}
+
+// WithHealthAddress - Serve the health, readiness and liveness endpoints on the given address.
+// health endpoints are disabled unless this option or the HEALTH_ADDRESS environment variable is set
+func WithHealthAddress(address string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.HealthAddress = address
+	}
+}
+
 func WithServiceAddress(address string) ServerOption {
 	return func(opts *NitricServer) {
 		opts.ServiceAddress = address
@@ -164,6 +181,34 @@ func WithPreCommands(commands [][]string) ServerOption {
 	}
 }
 
+// WithChildRestartPolicy - Set whether the child process is restarted when it exits
+func WithChildRestartPolicy(policy pm.RestartPolicy) ServerOption {
+	return func(opts *NitricServer) {
+		opts.ChildRestartPolicy = policy
+	}
+}
+
+// WithPreCommandRestartPolicy - Set whether pre commands are restarted when they exit
+func WithPreCommandRestartPolicy(policy pm.RestartPolicy) ServerOption {
+	return func(opts *NitricServer) {
+		opts.PreCommandRestartPolicy = policy
+	}
+}
+
+// WithChildStopGracePeriodSeconds - Set the time child processes have to exit after SIGTERM before they're killed
+func WithChildStopGracePeriodSeconds(gracePeriod int) ServerOption {
+	return func(opts *NitricServer) {
+		opts.ChildStopGracePeriodSeconds = gracePeriod
+	}
+}
+
+// WithDrainTimeoutSeconds - Set the time to wait for requests in flight to workers to complete when the server stops
+func WithDrainTimeoutSeconds(timeout int) ServerOption {
+	return func(opts *NitricServer) {
+		opts.DrainTimeoutSeconds = timeout
+	}
+}
+
 func WithChildTimeoutSeconds(timeout int) ServerOption {
 	return func(opts *NitricServer) {
 		opts.ChildTimeoutSeconds = timeout
@@ -15,11 +15,13 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
 	"net"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -28,10 +30,12 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	pm "github.com/nitrictech/nitric/core/pkg/process"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	httppb "github.com/nitrictech/nitric/core/pkg/proto/http/v1"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	keyvaluepb "github.com/nitrictech/nitric/core/pkg/proto/keyvalue/v1"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
@@ -43,6 +47,7 @@ import (
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
 	"github.com/nitrictech/nitric/core/pkg/server/runtime"
+	"github.com/nitrictech/nitric/core/pkg/workers"
 	"github.com/nitrictech/nitric/core/pkg/workers/apis"
 	"github.com/nitrictech/nitric/core/pkg/workers/http"
 	"github.com/nitrictech/nitric/core/pkg/workers/jobs"
@@ -55,9 +60,20 @@ import (
 type NitricServer struct {
 	processManager pm.ProcessManager
 	grpcServer     *grpc.Server
+	metricsServer  *metrics.MetricsServer
+	healthServer   *HealthServer
+
+	// State reported by the health checks
+	grpcServing    atomic.Bool
+	workersReady   atomic.Bool
+	gatewayStarted atomic.Bool
 
 	// Options
 	ServiceAddress string
+	// The address metrics are served on, metrics are disabled when empty
+	MetricsAddress string
+	// The address the health endpoints are served on, health endpoints are disabled when empty
+	HealthAddress string
 	// The command that will be used to invoke the child process
 	ChildCommand []string
 	// Commands that will be started before all others
@@ -65,6 +81,14 @@ type NitricServer struct {
 
 	// The total time to wait for the child process to be available in seconds
 	ChildTimeoutSeconds int
+	// Whether the child process is restarted when it exits
+	ChildRestartPolicy pm.RestartPolicy
+	// Whether pre commands are restarted when they exit
+	PreCommandRestartPolicy pm.RestartPolicy
+	// The time child processes have to exit after SIGTERM before they're killed, in seconds
+	ChildStopGracePeriodSeconds int
+	// The time to wait for requests in flight to workers to complete when the server stops, in seconds
+	DrainTimeoutSeconds int
 
 	// The minimum number of workers that need to be available
 	MinWorkers int
@@ -127,6 +151,37 @@ func (s *NitricServer) waitForMinimumWorkers(timeout int) error {
 	return nil
 }
 
+// livenessChecks fail when the child process or the gRPC server have stopped
+func (s *NitricServer) livenessChecks() []HealthCheck {
+	return []HealthCheck{
+		{Name: "process", Check: s.processManager.Alive},
+		{Name: "grpc", Check: func() error {
+			if !s.grpcServing.Load() {
+				return errors.New("gRPC server is not serving")
+			}
+			return nil
+		}},
+	}
+}
+
+// readinessChecks fail until the minimum number of workers are available and the gateway has started
+func (s *NitricServer) readinessChecks() []HealthCheck {
+	return []HealthCheck{
+		{Name: "workers", Check: func() error {
+			if !s.workersReady.Load() {
+				return fmt.Errorf("waiting for a minimum of %d workers, %d available", s.MinWorkers, s.WorkerCount())
+			}
+			return nil
+		}},
+		{Name: "gateway", Check: func() error {
+			if !s.gatewayStarted.Load() {
+				return errors.New("gateway is not started")
+			}
+			return nil
+		}},
+	}
+}
+
 type ServerStartOptions func(m *NitricServer)
 
 func WithGrpcServer(s *grpc.Server) ServerStartOptions {
@@ -157,6 +212,8 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	if s.grpcServer == nil {
 		opts := []grpc.ServerOption{
 			grpc.MaxConcurrentStreams(uint32(maxWorkers)), //#nosec G115 -- max workers checked for potential out of range or overflow errors
+			grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor),
+			grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor),
 		}
 
 		s.grpcServer = grpc.NewServer(opts...)
@@ -198,6 +255,17 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	}
 	batchpb.RegisterJobServer(s.grpcServer, s.JobHandlerPlugin)
 
+	introspectionServer := NewIntrospectionServer(
+		s.ApiPlugin,
+		s.HttpPlugin,
+		s.SchedulesPlugin,
+		s.TopicsListenerPlugin,
+		s.StorageListenerPlugin,
+		s.WebsocketListenerPlugin,
+		s.JobHandlerPlugin,
+	)
+	introspectionpb.RegisterIntrospectionServer(s.grpcServer, introspectionServer)
+
 	// Load & Register the service plugins
 	secretsServerWithValidation := decorators.SecretsServerWithValidation(s.SecretManagerPlugin)
 	keyvalueServerWithCompat := decorators.KeyValueServerWithCompat(s.KeyValuePlugin)
@@ -220,10 +288,32 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 
 	logger.Debug("Registered Gateway Plugin")
 
+	if s.MetricsAddress != "" {
+		s.metricsServer = metrics.NewMetricsServer(s.MetricsAddress, introspectionServer.RegisteredWorkers)
+
+		go func() {
+			if err := s.metricsServer.Start(); err != nil {
+				logger.Errorf("metrics server failed: %v", err)
+			}
+		}()
+	}
+
+	if s.HealthAddress != "" {
+		s.healthServer = NewHealthServer(s.HealthAddress, s.livenessChecks(), s.readinessChecks())
+
+		go func() {
+			if err := s.healthServer.Start(); err != nil {
+				logger.Errorf("health server failed: %v", err)
+			}
+		}()
+	}
+
 	// Start the gRPC server
+	s.grpcServing.Store(true)
 	go (func() {
 		logger.Debugf("Services listening on: %s", s.ServiceAddress)
 		err := s.grpcServer.Serve(lis)
+		s.grpcServing.Store(false)
 		if err != nil {
 			logger.Errorf("grpc serve %v", err)
 		}
@@ -242,6 +332,7 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	if err != nil {
 		return err
 	}
+	s.workersReady.Store(true)
 
 	gatewayErrchan := make(chan error)
 
@@ -259,6 +350,7 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 			JobHandlerPlugin:        s.JobHandlerPlugin,
 		})
 	}(gatewayErrchan)
+	s.gatewayStarted.Store(true)
 
 	processErrchan := make(chan error)
 	go func(errch chan error) {
@@ -283,10 +375,35 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	return exitErr
 }
 
+// Stop the server, draining requests before stopping the child processes.
+// The gateway stops accepting requests first, then requests already forwarded to workers are given
+// DrainTimeoutSeconds to complete before the child processes are stopped.
 func (s *NitricServer) Stop() {
+	s.gatewayStarted.Store(false)
 	_ = s.GatewayPlugin.Stop()
-	s.grpcServer.Stop()
+
+	drainTimeout := time.Duration(s.DrainTimeoutSeconds) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	logger.Debugf("Draining %d requests in flight to workers", workers.InFlightRequests())
+	if err := workers.WaitForInFlightRequests(ctx); err != nil {
+		logger.Warnf("%d requests were still in flight to workers after %s, stopping anyway", workers.InFlightRequests(), drainTimeout)
+	}
+
 	s.processManager.StopAll()
+
+	if s.metricsServer != nil {
+		_ = s.metricsServer.Stop()
+	}
+
+	if s.healthServer != nil {
+		_ = s.healthServer.Stop()
+	}
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
 }
 
 // New - Create a new nitric server
@@ -295,6 +412,9 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		// The resource service is defaulted, because it typically isn't required to be implemented for runtime servers.
 		ResourcesPlugin: &runtime.RuntimeResourceService{},
 		MinWorkers:      -1,
+		// Durations may be set to 0, so -1 indicates they're unset
+		ChildStopGracePeriodSeconds: -1,
+		DrainTimeoutSeconds:         -1,
 	}
 
 	for _, opt := range opts {
@@ -306,6 +426,14 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		m.ServiceAddress = env.SERVICE_ADDRESS.String()
 	}
 
+	if m.MetricsAddress == "" {
+		m.MetricsAddress = env.METRICS_ADDRESS.String()
+	}
+
+	if m.HealthAddress == "" {
+		m.HealthAddress = env.HEALTH_ADDRESS.String()
+	}
+
 	minWorkersEnv, err := env.MIN_WORKERS.Int()
 	if err == nil && m.MinWorkers < 0 {
 		logger.Debugf("MIN_WORKERS environment variable set to %d", minWorkersEnv)
@@ -319,6 +447,28 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		return nil, fmt.Errorf("invalid WORKER_TIMEOUT: %w", err)
 	}
 
+	if m.ChildRestartPolicy == "" {
+		m.ChildRestartPolicy = pm.RestartPolicyFromString(env.CHILD_RESTART_POLICY.String())
+	}
+
+	if m.PreCommandRestartPolicy == "" {
+		m.PreCommandRestartPolicy = pm.RestartPolicyFromString(env.PRE_COMMAND_RESTART_POLICY.String())
+	}
+
+	if m.ChildStopGracePeriodSeconds < 0 {
+		m.ChildStopGracePeriodSeconds, err = env.CHILD_STOP_GRACE_PERIOD.Int()
+		if err != nil || m.ChildStopGracePeriodSeconds < 0 {
+			return nil, fmt.Errorf("invalid CHILD_STOP_GRACE_PERIOD: %s", env.CHILD_STOP_GRACE_PERIOD.String())
+		}
+	}
+
+	if m.DrainTimeoutSeconds < 0 {
+		m.DrainTimeoutSeconds, err = env.DRAIN_TIMEOUT.Int()
+		if err != nil || m.DrainTimeoutSeconds < 0 {
+			return nil, fmt.Errorf("invalid DRAIN_TIMEOUT: %s", env.DRAIN_TIMEOUT.String())
+		}
+	}
+
 	if m.ChildCommand == nil {
 		if len(os.Args) > 1 {
 			m.ChildCommand = os.Args[1:]
@@ -329,7 +479,11 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		return nil, errors.New("missing gateway plugin, Gateway plugin must not be nil")
 	}
 
-	m.processManager = pm.NewProcessManager(m.ChildCommand, m.PreCommands)
+	m.processManager = pm.NewProcessManager(m.ChildCommand, m.PreCommands,
+		pm.WithUserRestartPolicy(m.ChildRestartPolicy),
+		pm.WithPreCommandRestartPolicy(m.PreCommandRestartPolicy),
+		pm.WithGracePeriod(time.Duration(m.ChildStopGracePeriodSeconds)*time.Second),
+	)
 
 	return m, nil
 }
@@ -21,18 +21,20 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	"github.com/nitrictech/nitric/core/pkg/workers"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type WorkerConnection = workers.WorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage]
 
 type RouteWorker struct {
 	routeMatcher string
+	segments     []routeSegment
 	methods      []string
-	connection   *WorkerConnection
+	workers      *workers.WorkerPool[*apispb.ServerMessage, *apispb.ClientMessage]
 }
 
 // slashSplitter - used to split strings, with the same output regardless of leading or trailing slashes
@@ -43,7 +45,7 @@ func slashSplitter(c rune) bool {
 
 type ApiRequestHandler interface {
 	apispb.ApiServer
-	HandleRequest(apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error)
+	HandleRequest(ctx context.Context, apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error)
 	WorkerCount() int
 }
 
@@ -53,89 +55,183 @@ func splitPath(p string) []string {
 	return strings.FieldsFunc(p, slashSplitter)
 }
 
-func extractPathParams(route string, requestPath string) (map[string]string, error) {
-	requestPathSegments := splitPath(requestPath)
-	pathSegments := splitPath(route)
+// pathParams extracts the values of the route's params from a request path matched to it
+func (r *RouteWorker) pathParams(requestPath []string) map[string]string {
 	params := make(map[string]string)
 
-	if len(requestPathSegments) != len(pathSegments) {
-		return nil, fmt.Errorf("path template mismatch")
-	}
-
-	for i, p := range pathSegments {
-		if !strings.HasPrefix(p, ":") && p != requestPathSegments[i] {
-			return nil, fmt.Errorf("path template mismatch")
-		} else if strings.HasPrefix(p, ":") {
-			params[strings.Replace(p, ":", "", 1)] = requestPathSegments[i]
+	for i, segment := range r.segments {
+		switch segment.kind {
+		case paramSegment:
+			params[segment.value] = requestPath[i]
+		case wildcardSegment:
+			if segment.value != "" {
+				params[segment.value] = strings.Join(requestPath[i:], "/")
+			}
 		}
 	}
 
-	return params, nil
+	return params
 }
 
-func (r *RouteWorker) isSupportedRequest(httpRequest *apispb.HttpRequest) bool {
-	if !slices.Contains[[]string](r.methods, httpRequest.GetMethod()) {
+// handlesSameRoute returns true if the route worker serves exactly the given route and methods
+func (r *RouteWorker) handlesSameRoute(segments []routeSegment, methods []string) bool {
+	if !slices.Equal(r.segments, segments) {
 		return false
 	}
 
-	_, err := extractPathParams(r.routeMatcher, httpRequest.GetPath())
+	existing := slices.Clone(r.methods)
+	slices.Sort(existing)
+	requested := slices.Clone(methods)
+	slices.Sort(requested)
 
-	return err == nil
+	return slices.Equal(existing, requested)
 }
 
 type ApiName = string
 
 type RouteWorkerManager struct {
-	routeWorkerMap map[ApiName][]*RouteWorker
+	routeWorkerMap map[ApiName]*routeNode
+	strategy       workers.LoadBalancingStrategy
 	lock           sync.RWMutex
 }
 
-var _ apispb.ApiServer = &RouteWorkerManager{}
+var (
+	_ apispb.ApiServer       = &RouteWorkerManager{}
+	_ workers.Introspectable = &RouteWorkerManager{}
+)
 
 func (s *RouteWorkerManager) WorkerCount() int {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
 	total := 0
-	for _, workers := range s.routeWorkerMap {
-		total += len(workers)
+	for _, routes := range s.routeWorkerMap {
+		routes.walk(func(rw *RouteWorker) {
+			total += rw.workers.Len()
+		})
 	}
 
 	return total
 }
 
-func (a *RouteWorkerManager) ApiDetails(ctx context.Context, req *apispb.ApiDetailsRequest) (*apispb.ApiDetailsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not Implemented")
+// ApiDetails returns the routes currently registered for an API.
+// Cloud providers extend the details with the URL of the deployed API.
+func (s *RouteWorkerManager) ApiDetails(ctx context.Context, req *apispb.ApiDetailsRequest) (*apispb.ApiDetailsResponse, error) {
+	return &apispb.ApiDetailsResponse{
+		Routes: s.routeDetails(req.ApiName),
+	}, nil
+}
+
+// routeDetails returns the routes registered for an API, sorted by path
+func (s *RouteWorkerManager) routeDetails(apiName string) []*apispb.ApiRouteDetails {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	details := []*apispb.ApiRouteDetails{}
+
+	routes, ok := s.routeWorkerMap[apiName]
+	if !ok {
+		return details
+	}
+
+	routes.walk(func(rw *RouteWorker) {
+		details = append(details, &apispb.ApiRouteDetails{
+			Path:        rw.routeMatcher,
+			Methods:     rw.methods,
+			WorkerCount: int32(rw.workers.Len()),
+		})
+	})
+
+	slices.SortFunc(details, func(a, b *apispb.ApiRouteDetails) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return details
+}
+
+// RegisteredWorkers describes the workers registered for each API route
+func (s *RouteWorkerManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	s.lock.RLock()
+	apiNames := make([]string, 0, len(s.routeWorkerMap))
+	for apiName := range s.routeWorkerMap {
+		apiNames = append(apiNames, apiName)
+	}
+	s.lock.RUnlock()
+
+	slices.Sort(apiNames)
+
+	registered := []*introspectionpb.RegisteredWorker{}
+	for _, apiName := range apiNames {
+		for _, route := range s.routeDetails(apiName) {
+			registered = append(registered, &introspectionpb.RegisteredWorker{
+				Type:        introspectionpb.WorkerType_Api,
+				Name:        apiName,
+				Trigger:     fmt.Sprintf("%s %s", strings.Join(route.Methods, ","), route.Path),
+				WorkerCount: route.WorkerCount,
+			})
+		}
+	}
+
+	return registered
 }
 
 // registerRouteHandler registers a worker by the routes and methods it handles.
+// Workers registered for the same route and methods share requests between them,
+// while routes which would be ambiguous with an existing route are rejected.
 func (s *RouteWorkerManager) registerRouteHandler(apiName string, path string, methods []string, worker *WorkerConnection) (*RouteWorker, error) {
+	segments, err := parseRoute(path)
+	if err != nil {
+		return nil, err
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	if _, exists := s.routeWorkerMap[apiName]; !exists {
-		s.routeWorkerMap[apiName] = []*RouteWorker{}
+		s.routeWorkerMap[apiName] = newRouteNode()
+	}
+
+	if node := s.routeWorkerMap[apiName].find(segments, false); node != nil {
+		for _, rw := range node.routes {
+			if rw.handlesSameRoute(segments, methods) {
+				rw.workers.Add(worker)
+				return rw, nil
+			}
+		}
 	}
 
 	rw := &RouteWorker{
 		routeMatcher: path,
+		segments:     segments,
 		methods:      methods,
-		connection:   worker,
+		workers:      workers.NewWorkerPool[*apispb.ServerMessage, *apispb.ClientMessage](s.strategy),
 	}
-	s.routeWorkerMap[apiName] = append(s.routeWorkerMap[apiName], rw)
+
+	if err := s.routeWorkerMap[apiName].insert(rw); err != nil {
+		return nil, err
+	}
+
+	rw.workers.Add(worker)
 
 	return rw, nil
 }
 
-func (s *RouteWorkerManager) unregisterRouteHandler(apiName string, worker *RouteWorker) {
+func (s *RouteWorkerManager) unregisterRouteHandler(apiName string, routeWorker *RouteWorker, worker *WorkerConnection) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	s.routeWorkerMap[apiName] = slices.DeleteFunc[[]*RouteWorker](s.routeWorkerMap[apiName], func(rw *RouteWorker) bool {
-		return rw == worker
-	})
+	if routeWorker.workers.Remove(worker) > 0 {
+		return
+	}
 
-	if len(s.routeWorkerMap[apiName]) == 0 {
+	routes, ok := s.routeWorkerMap[apiName]
+	if !ok {
+		return
+	}
+
+	routes.remove(routeWorker, routeWorker.segments)
+
+	if routes.isEmpty() {
 		delete(s.routeWorkerMap, apiName)
 	}
 }
@@ -162,7 +258,7 @@ func (s *RouteWorkerManager) Serve(stream apispb.Api_ServeServer) error {
 		return err
 	}
 
-	defer s.unregisterRouteHandler(apiName, routeWorker)
+	defer s.unregisterRouteHandler(apiName, routeWorker, wrkr)
 
 	// send ack of registration
 	err = stream.Send(&apispb.ServerMessage{
@@ -177,7 +273,8 @@ func (s *RouteWorkerManager) Serve(stream apispb.Api_ServeServer) error {
 	return wrkr.Run()
 }
 
-func (s *RouteWorkerManager) HandleRequest(apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error) {
+// HandleRequest forwards an API request to the worker registered for its route, waiting at most WorkerTimeout unless ctx has an earlier deadline
+func (s *RouteWorkerManager) HandleRequest(ctx context.Context, apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
@@ -185,34 +282,36 @@ func (s *RouteWorkerManager) HandleRequest(apiName string, request *apispb.Serve
 		request.Id = workers.GenerateUniqueId()
 	}
 
-	possibleHandlers, ok := s.routeWorkerMap[apiName]
+	ctx = logger.WithRequestId(ctx, request.Id)
+
+	routes, ok := s.routeWorkerMap[apiName]
 	if !ok {
 		return nil, fmt.Errorf("no routes registered for api %s", apiName)
 	}
 
-	// Handlers are applied using Highlander rules (THERE CAN BE ONLY ONE!!!)
-	var theOneTrueHandler *RouteWorker = nil
-	for _, handler := range possibleHandlers {
-		if handler.isSupportedRequest(request.GetHttpRequest()) {
-			// Praise the one true handler 🙌
-			theOneTrueHandler = handler
-			break
-		}
-	}
+	// Handlers are applied using Highlander rules (THERE CAN BE ONLY ONE!!!), the most specific route wins
+	requestPath := splitPath(request.GetHttpRequest().GetPath())
+	theOneTrueHandler := routes.lookup(requestPath, request.GetHttpRequest().GetMethod())
 
 	if theOneTrueHandler == nil {
 		return nil, fmt.Errorf("no worker registered for Api %s on route: %s - %s", apiName, request.GetHttpRequest().GetMethod(), request.GetHttpRequest().GetPath())
 	}
 
 	if request.GetHttpRequest().GetPathParams() == nil || len(request.GetHttpRequest().GetPathParams()) < 1 {
-		pathParams, err := extractPathParams(theOneTrueHandler.routeMatcher, request.GetHttpRequest().GetPath())
-		if err != nil {
-			return nil, err
-		}
-		request.GetHttpRequest().PathParams = pathParams
+		request.GetHttpRequest().PathParams = theOneTrueHandler.pathParams(requestPath)
+	}
+
+	ctx, cancel := workers.WithWorkerTimeout(ctx)
+	defer cancel()
+
+	worker := theOneTrueHandler.workers.Next()
+	if worker == nil {
+		return nil, fmt.Errorf("no worker available for Api %s on route: %s - %s", apiName, request.GetHttpRequest().GetMethod(), request.GetHttpRequest().GetPath())
 	}
 
-	resp, err := theOneTrueHandler.connection.Send(request)
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Api)
+	resp, err := worker.Send(ctx, request)
+	timer.ObserveDuration(err)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +321,8 @@ func (s *RouteWorkerManager) HandleRequest(apiName string, request *apispb.Serve
 
 func New() *RouteWorkerManager {
 	return &RouteWorkerManager{
-		routeWorkerMap: map[string][]*RouteWorker{},
+		routeWorkerMap: map[string]*routeNode{},
+		strategy:       workers.LoadBalancingStrategyFromEnv(),
 		lock:           sync.RWMutex{},
 	}
 }
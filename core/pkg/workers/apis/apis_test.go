@@ -0,0 +1,91 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	"context"
+
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouteWorkerManager", func() {
+	var manager *RouteWorkerManager
+
+	register := func(apiName string, path string, methods ...string) (*RouteWorker, *WorkerConnection) {
+		worker := &WorkerConnection{}
+		rw, err := manager.registerRouteHandler(apiName, path, methods, worker)
+		Expect(err).ShouldNot(HaveOccurred())
+		return rw, worker
+	}
+
+	BeforeEach(func() {
+		manager = New()
+	})
+
+	Context("ApiDetails", func() {
+		It("should return the registered routes sorted by path", func() {
+			register("test-api", "/users/:id", "GET", "DELETE")
+			register("test-api", "/users", "POST")
+			register("test-api", "/users", "POST")
+			register("other-api", "/other", "GET")
+
+			details, err := manager.ApiDetails(context.TODO(), &apispb.ApiDetailsRequest{ApiName: "test-api"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(details.Routes).To(HaveLen(2))
+			Expect(details.Routes[0].Path).To(Equal("/users"))
+			Expect(details.Routes[0].Methods).To(Equal([]string{"POST"}))
+			Expect(details.Routes[0].WorkerCount).To(Equal(int32(2)))
+			Expect(details.Routes[1].Path).To(Equal("/users/:id"))
+			Expect(details.Routes[1].Methods).To(Equal([]string{"GET", "DELETE"}))
+			Expect(details.Routes[1].WorkerCount).To(Equal(int32(1)))
+		})
+
+		It("should no longer return routes once their workers are unregistered", func() {
+			rw, worker := register("test-api", "/users", "GET")
+			manager.unregisterRouteHandler("test-api", rw, worker)
+
+			details, err := manager.ApiDetails(context.TODO(), &apispb.ApiDetailsRequest{ApiName: "test-api"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(details.Routes).To(BeEmpty())
+		})
+
+		It("should return no routes for an unknown api", func() {
+			details, err := manager.ApiDetails(context.TODO(), &apispb.ApiDetailsRequest{ApiName: "unknown"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(details.Routes).To(BeEmpty())
+		})
+	})
+
+	Context("RegisteredWorkers", func() {
+		It("should describe each route of each api", func() {
+			register("b-api", "/things", "GET", "POST")
+			register("a-api", "/items/*rest", "GET")
+
+			registered := manager.RegisteredWorkers()
+
+			Expect(registered).To(HaveLen(2))
+			Expect(registered[0].Type).To(Equal(introspectionpb.WorkerType_Api))
+			Expect(registered[0].Name).To(Equal("a-api"))
+			Expect(registered[0].Trigger).To(Equal("GET /items/*rest"))
+			Expect(registered[1].Name).To(Equal("b-api"))
+			Expect(registered[1].Trigger).To(Equal("GET,POST /things"))
+			Expect(registered[1].WorkerCount).To(Equal(int32(1)))
+		})
+	})
+})
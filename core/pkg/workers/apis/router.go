@@ -0,0 +1,226 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	staticSegment segmentKind = iota
+	paramSegment
+	wildcardSegment
+)
+
+// routeSegment is a single parsed segment of a route, e.g. "users", ":id" or "*path"
+type routeSegment struct {
+	kind segmentKind
+	// the literal value of a static segment, or the name of a param/wildcard segment
+	value string
+}
+
+// parseRoute - splits a route into its segments, validating that wildcards only appear as the final segment.
+// Leading and trailing slashes are ignored, so "/users/" and "users" are equivalent.
+func parseRoute(route string) ([]routeSegment, error) {
+	parts := splitPath(route)
+	segments := make([]routeSegment, 0, len(parts))
+
+	for i, part := range parts {
+		switch {
+		case strings.HasPrefix(part, ":"):
+			if len(part) == 1 {
+				return nil, fmt.Errorf("invalid route %s, path params must be named", route)
+			}
+			segments = append(segments, routeSegment{kind: paramSegment, value: part[1:]})
+		case strings.HasPrefix(part, "*"):
+			if i != len(parts)-1 {
+				return nil, fmt.Errorf("invalid route %s, wildcards are only supported as the final segment", route)
+			}
+			segments = append(segments, routeSegment{kind: wildcardSegment, value: part[1:]})
+		default:
+			segments = append(segments, routeSegment{kind: staticSegment, value: part})
+		}
+	}
+
+	return segments, nil
+}
+
+// routeNode - a node in the route trie of an API.
+//
+// Requests are matched against static children first, then params, then wildcards,
+// so /users/me always takes precedence over /users/:id, regardless of registration order.
+type routeNode struct {
+	static   map[string]*routeNode
+	param    *routeNode
+	wildcard *routeNode
+	// routes terminating at this node, which all share the same shape but serve different methods
+	routes []*RouteWorker
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		static: map[string]*routeNode{},
+		routes: []*RouteWorker{},
+	}
+}
+
+// child returns the child node for a segment, creating it if create is true
+func (n *routeNode) child(segment routeSegment, create bool) *routeNode {
+	switch segment.kind {
+	case paramSegment:
+		if n.param == nil && create {
+			n.param = newRouteNode()
+		}
+		return n.param
+	case wildcardSegment:
+		if n.wildcard == nil && create {
+			n.wildcard = newRouteNode()
+		}
+		return n.wildcard
+	default:
+		if _, ok := n.static[segment.value]; !ok && create {
+			n.static[segment.value] = newRouteNode()
+		}
+		return n.static[segment.value]
+	}
+}
+
+// find returns the node for a route, or nil if no such node exists
+func (n *routeNode) find(segments []routeSegment, create bool) *routeNode {
+	node := n
+	for _, segment := range segments {
+		node = node.child(segment, create)
+		if node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// insert adds a route worker to the trie, returning an error if it's ambiguous with an existing route
+func (n *routeNode) insert(rw *RouteWorker) error {
+	node := n.find(rw.segments, true)
+
+	for _, existing := range node.routes {
+		for _, method := range rw.methods {
+			if slices.Contains(existing.methods, method) {
+				return fmt.Errorf("route %s %s is ambiguous with existing route %s %s", method, rw.routeMatcher, method, existing.routeMatcher)
+			}
+		}
+	}
+
+	node.routes = append(node.routes, rw)
+
+	return nil
+}
+
+// remove deletes a route worker from the trie, pruning any branches left empty
+func (n *routeNode) remove(rw *RouteWorker, segments []routeSegment) {
+	if len(segments) == 0 {
+		n.routes = slices.DeleteFunc(n.routes, func(r *RouteWorker) bool {
+			return r == rw
+		})
+		return
+	}
+
+	child := n.child(segments[0], false)
+	if child == nil {
+		return
+	}
+
+	child.remove(rw, segments[1:])
+
+	if child.isEmpty() {
+		switch segments[0].kind {
+		case paramSegment:
+			n.param = nil
+		case wildcardSegment:
+			n.wildcard = nil
+		default:
+			delete(n.static, segments[0].value)
+		}
+	}
+}
+
+func (n *routeNode) isEmpty() bool {
+	return len(n.routes) == 0 && len(n.static) == 0 && n.param == nil && n.wildcard == nil
+}
+
+// lookup finds the most specific route worker serving the method for the given request path segments.
+// Less specific branches are only tried when a more specific branch has no matching route.
+func (n *routeNode) lookup(path []string, method string) *RouteWorker {
+	if len(path) == 0 {
+		if rw := n.routeFor(method); rw != nil {
+			return rw
+		}
+		// a wildcard also matches an empty remainder
+		if n.wildcard != nil {
+			return n.wildcard.routeFor(method)
+		}
+		return nil
+	}
+
+	if child, ok := n.static[path[0]]; ok {
+		if rw := child.lookup(path[1:], method); rw != nil {
+			return rw
+		}
+	}
+
+	if n.param != nil {
+		if rw := n.param.lookup(path[1:], method); rw != nil {
+			return rw
+		}
+	}
+
+	if n.wildcard != nil {
+		return n.wildcard.routeFor(method)
+	}
+
+	return nil
+}
+
+func (n *routeNode) routeFor(method string) *RouteWorker {
+	for _, rw := range n.routes {
+		if slices.Contains(rw.methods, method) {
+			return rw
+		}
+	}
+
+	return nil
+}
+
+// walk calls fn for every route worker in the trie
+func (n *routeNode) walk(fn func(*RouteWorker)) {
+	for _, rw := range n.routes {
+		fn(rw)
+	}
+
+	for _, child := range n.static {
+		child.walk(fn)
+	}
+
+	if n.param != nil {
+		n.param.walk(fn)
+	}
+
+	if n.wildcard != nil {
+		n.wildcard.walk(fn)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route matching", func() {
+	var manager *RouteWorkerManager
+
+	register := func(path string, methods ...string) *RouteWorker {
+		rw, err := manager.registerRouteHandler("test-api", path, methods, &WorkerConnection{})
+		Expect(err).ShouldNot(HaveOccurred())
+		return rw
+	}
+
+	lookup := func(method string, path string) *RouteWorker {
+		return manager.routeWorkerMap["test-api"].lookup(splitPath(path), method)
+	}
+
+	BeforeEach(func() {
+		manager = New()
+	})
+
+	When("static and param routes overlap", func() {
+		It("should prefer the static route regardless of registration order", func() {
+			byId := register("/users/:id", "GET")
+			me := register("/users/me", "GET")
+
+			Expect(lookup("GET", "/users/me")).To(BeIdenticalTo(me))
+			Expect(lookup("GET", "/users/123")).To(BeIdenticalTo(byId))
+		})
+
+		It("should fall back to the param route when the static route doesn't serve the method", func() {
+			byId := register("/users/:id", "DELETE")
+			register("/users/me", "GET")
+
+			Expect(lookup("DELETE", "/users/me")).To(BeIdenticalTo(byId))
+		})
+
+		It("should backtrack when a static branch has no matching route deeper in the path", func() {
+			posts := register("/users/:id/posts", "GET")
+			register("/users/me/settings", "GET")
+
+			Expect(lookup("GET", "/users/me/posts")).To(BeIdenticalTo(posts))
+		})
+	})
+
+	When("matching trailing slashes", func() {
+		It("should treat them as optional", func() {
+			users := register("/users/", "GET")
+
+			Expect(lookup("GET", "/users")).To(BeIdenticalTo(users))
+			Expect(lookup("GET", "/users/")).To(BeIdenticalTo(users))
+		})
+	})
+
+	When("using wildcard routes", func() {
+		It("should greedily match the remainder of the path", func() {
+			files := register("/files/*path", "GET")
+
+			rw := lookup("GET", "/files/docs/2024/report.pdf")
+			Expect(rw).To(BeIdenticalTo(files))
+			Expect(rw.pathParams(splitPath("/files/docs/2024/report.pdf"))).To(Equal(map[string]string{
+				"path": "docs/2024/report.pdf",
+			}))
+		})
+
+		It("should give param routes precedence over wildcards", func() {
+			register("/files/*", "GET")
+			byName := register("/files/:name", "GET")
+
+			Expect(lookup("GET", "/files/report.pdf")).To(BeIdenticalTo(byName))
+		})
+
+		It("should reject wildcards that are not the final segment", func() {
+			_, err := manager.registerRouteHandler("test-api", "/files/*/meta", []string{"GET"}, &WorkerConnection{})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("extracting path params", func() {
+		It("should map param names to their request values", func() {
+			rw := register("/orgs/:org/users/:user", "GET")
+
+			Expect(rw.pathParams(splitPath("/orgs/nitric/users/123"))).To(Equal(map[string]string{
+				"org":  "nitric",
+				"user": "123",
+			}))
+		})
+	})
+
+	When("registering ambiguous routes", func() {
+		It("should reject routes with the same shape and overlapping methods", func() {
+			register("/users/:id", "GET", "POST")
+
+			_, err := manager.registerRouteHandler("test-api", "/users/:userId", []string{"POST"}, &WorkerConnection{})
+			Expect(err).To(MatchError(ContainSubstring("ambiguous")))
+		})
+
+		It("should allow routes with the same shape for different methods", func() {
+			get := register("/users/:id", "GET")
+			del := register("/users/:userId", "DELETE")
+
+			Expect(lookup("GET", "/users/1")).To(BeIdenticalTo(get))
+			Expect(lookup("DELETE", "/users/1")).To(BeIdenticalTo(del))
+		})
+
+		It("should pool workers registering an identical route", func() {
+			first := register("/users/:id", "GET", "POST")
+			second := register("/users/:id/", "POST", "GET")
+
+			Expect(second).To(BeIdenticalTo(first))
+			Expect(manager.WorkerCount()).To(Equal(2))
+		})
+	})
+
+	When("unregistering routes", func() {
+		It("should remove the route once its last worker is gone", func() {
+			worker := &WorkerConnection{}
+			rw, err := manager.registerRouteHandler("test-api", "/users/:id", []string{"GET"}, worker)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			manager.unregisterRouteHandler("test-api", rw, worker)
+
+			Expect(manager.routeWorkerMap).ToNot(HaveKey("test-api"))
+			Expect(manager.WorkerCount()).To(Equal(0))
+		})
+	})
+})
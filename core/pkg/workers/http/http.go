@@ -20,7 +20,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	httppb "github.com/nitrictech/nitric/core/pkg/proto/http/v1"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	"github.com/valyala/fasthttp"
 )
 
@@ -77,6 +79,22 @@ func (h *HttpServer) WorkerCount() int {
 	return 0
 }
 
+// RegisteredWorkers describes the proxied HTTP server, if one is registered
+func (h *HttpServer) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	if h.host == "" {
+		return []*introspectionpb.RegisteredWorker{}
+	}
+
+	return []*introspectionpb.RegisteredWorker{
+		{
+			Type:        introspectionpb.WorkerType_Http,
+			Name:        h.host,
+			Trigger:     h.host,
+			WorkerCount: 1,
+		},
+	}
+}
+
 const (
 	// Dial timeout for initial server check
 	initialStartTimeout = 5 * time.Second
@@ -139,7 +157,10 @@ func (srv *HttpServer) HandleRequest(request *fasthttp.Request) (*fasthttp.Respo
 	requestCopy.URI().SetHost(srv.host)
 	requestCopy.URI().SetScheme("http")
 
-	if err := fasthttp.Do(requestCopy, &response); err != nil {
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Http)
+	err := fasthttp.Do(requestCopy, &response)
+	timer.ObserveDuration(err)
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,40 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"cmp"
+	"strings"
+
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
+)
+
+// Introspectable is implemented by worker managers which can describe the workers registered with them
+type Introspectable interface {
+	RegisteredWorkers() []*introspectionpb.RegisteredWorker
+}
+
+// CompareRegisteredWorkers orders registered workers by type, name and trigger
+func CompareRegisteredWorkers(a, b *introspectionpb.RegisteredWorker) int {
+	if a.Type != b.Type {
+		return cmp.Compare(a.Type, b.Type)
+	}
+
+	if a.Name != b.Name {
+		return strings.Compare(a.Name, b.Name)
+	}
+
+	return strings.Compare(a.Trigger, b.Trigger)
+}
@@ -15,11 +15,16 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/nitrictech/nitric/core/pkg/help"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	"github.com/nitrictech/nitric/core/pkg/workers"
 )
 
@@ -29,11 +34,14 @@ type WorkerConnection = workers.WorkerRequestBroker[*batchpb.ServerMessage, *bat
 
 type JobRequestHandler interface {
 	batchpb.JobServer
-	HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.ClientMessage, error)
+	HandleJobRequest(ctx context.Context, request *batchpb.ServerMessage) (*batchpb.ClientMessage, error)
 	WorkerCount() int
 }
 
-var _ JobRequestHandler = (*JobManager)(nil)
+var (
+	_ JobRequestHandler      = (*JobManager)(nil)
+	_ workers.Introspectable = (*JobManager)(nil)
+)
 
 type JobManager struct {
 	handlers map[JobName]*WorkerConnection
@@ -70,6 +78,26 @@ func (s *JobManager) WorkerCount() int {
 	return len(s.handlers)
 }
 
+// RegisteredWorkers describes the handler registered for each job
+func (s *JobManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	registered := make([]*introspectionpb.RegisteredWorker, 0, len(s.handlers))
+	for jobName := range s.handlers {
+		registered = append(registered, &introspectionpb.RegisteredWorker{
+			Type:        introspectionpb.WorkerType_Job,
+			Name:        jobName,
+			Trigger:     jobName,
+			WorkerCount: 1,
+		})
+	}
+
+	slices.SortFunc(registered, workers.CompareRegisteredWorkers)
+
+	return registered
+}
+
 // Subscribe allows the local nitric server to register new subscribers
 //
 //	called by Nitric applications wishing to subscribe to a topic.
@@ -123,11 +151,14 @@ func (s *JobManager) findMatchingHandler(jobName string) (*WorkerConnection, err
 	return worker, nil
 }
 
-func (s *JobManager) HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.ClientMessage, error) {
+// HandleJobRequest forwards a job to its handler. Jobs are long running, so no default timeout applies, only the deadline of ctx.
+func (s *JobManager) HandleJobRequest(ctx context.Context, request *batchpb.ServerMessage) (*batchpb.ClientMessage, error) {
 	if request.Id == "" {
 		request.Id = workers.GenerateUniqueId()
 	}
 
+	ctx = logger.WithRequestId(ctx, request.Id)
+
 	messageRequest := request.GetJobRequest()
 	if messageRequest == nil {
 		return nil, fmt.Errorf("invalid request, expected job request. %s", help.BugInNitricHelpText())
@@ -139,7 +170,9 @@ func (s *JobManager) HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.
 		return nil, err
 	}
 
-	resp, err := handler.Send(request)
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Job)
+	resp, err := handler.Send(ctx, request)
+	timer.ObserveDuration(err)
 	if err != nil {
 		return nil, err
 	}
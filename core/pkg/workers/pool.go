@@ -0,0 +1,128 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// LoadBalancingStrategy determines how requests are distributed across workers registered for the same trigger
+type LoadBalancingStrategy string
+
+const (
+	// RoundRobin sends requests to each worker in turn
+	RoundRobin LoadBalancingStrategy = "round-robin"
+	// LeastInFlight sends requests to the worker with the fewest requests awaiting a response
+	LeastInFlight LoadBalancingStrategy = "least-in-flight"
+)
+
+// LoadBalancingStrategyFromEnv returns the strategy configured by WORKER_LOAD_BALANCING, defaulting to RoundRobin
+func LoadBalancingStrategyFromEnv() LoadBalancingStrategy {
+	strategy := LoadBalancingStrategy(strings.ToLower(env.WORKER_LOAD_BALANCING.String()))
+
+	switch strategy {
+	case RoundRobin, LeastInFlight:
+		return strategy
+	default:
+		logger.Warnf("unknown WORKER_LOAD_BALANCING strategy %q, defaulting to %s", strategy, RoundRobin)
+		return RoundRobin
+	}
+}
+
+// WorkerPool is a set of workers registered for the same trigger (e.g. API route, schedule or websocket event),
+// which distributes requests across its workers using its LoadBalancingStrategy.
+type WorkerPool[Request IdentifiableMessage, Response IdentifiableMessage] struct {
+	strategy LoadBalancingStrategy
+	workers  []*WorkerRequestBroker[Request, Response]
+	next     int
+	lock     sync.Mutex
+}
+
+// Add a worker to the pool
+func (p *WorkerPool[Request, Response]) Add(worker *WorkerRequestBroker[Request, Response]) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.workers = append(p.workers, worker)
+}
+
+// Remove a worker from the pool, returning the number of workers remaining
+func (p *WorkerPool[Request, Response]) Remove(worker *WorkerRequestBroker[Request, Response]) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.workers = slices.DeleteFunc(p.workers, func(w *WorkerRequestBroker[Request, Response]) bool {
+		return w == worker
+	})
+
+	return len(p.workers)
+}
+
+// Contains returns true if the worker is a member of the pool
+func (p *WorkerPool[Request, Response]) Contains(worker *WorkerRequestBroker[Request, Response]) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return slices.Contains(p.workers, worker)
+}
+
+// Len returns the number of workers in the pool
+func (p *WorkerPool[Request, Response]) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return len(p.workers)
+}
+
+// Next returns the worker that should handle the next request, or nil if the pool is empty
+func (p *WorkerPool[Request, Response]) Next() *WorkerRequestBroker[Request, Response] {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.workers) == 0 {
+		return nil
+	}
+
+	// start from the next worker in turn, so ties between least-in-flight workers are also shared evenly
+	start := p.next % len(p.workers)
+	p.next = start + 1
+
+	if p.strategy != LeastInFlight {
+		return p.workers[start]
+	}
+
+	selected := p.workers[start]
+	for i := 1; i < len(p.workers); i++ {
+		candidate := p.workers[(start+i)%len(p.workers)]
+		if candidate.InFlight() < selected.InFlight() {
+			selected = candidate
+		}
+	}
+
+	return selected
+}
+
+// NewWorkerPool creates an empty pool of workers, balanced using the given strategy
+func NewWorkerPool[Request IdentifiableMessage, Response IdentifiableMessage](strategy LoadBalancingStrategy) *WorkerPool[Request, Response] {
+	return &WorkerPool[Request, Response]{
+		strategy: strategy,
+		workers:  []*WorkerRequestBroker[Request, Response]{},
+	}
+}
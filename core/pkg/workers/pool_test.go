@@ -0,0 +1,97 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+type apiWorker = workers.WorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage]
+
+func newApiWorker() *apiWorker {
+	return workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](&mockWorkerStream{
+		responses: make(chan *apispb.ClientMessage, 10),
+	})
+}
+
+var _ = Describe("WorkerPool", func() {
+	When("the pool is empty", func() {
+		It("should not return a worker", func() {
+			pool := workers.NewWorkerPool[*apispb.ServerMessage, *apispb.ClientMessage](workers.RoundRobin)
+			Expect(pool.Next()).To(BeNil())
+		})
+	})
+
+	When("removing workers", func() {
+		It("should return the number of workers remaining", func() {
+			pool := workers.NewWorkerPool[*apispb.ServerMessage, *apispb.ClientMessage](workers.RoundRobin)
+			first, second := newApiWorker(), newApiWorker()
+			pool.Add(first)
+			pool.Add(second)
+
+			Expect(pool.Remove(first)).To(Equal(1))
+			Expect(pool.Contains(first)).To(BeFalse())
+			Expect(pool.Next()).To(Equal(second))
+		})
+	})
+
+	When("using the round-robin strategy", func() {
+		It("should send requests to each worker in turn", func() {
+			pool := workers.NewWorkerPool[*apispb.ServerMessage, *apispb.ClientMessage](workers.RoundRobin)
+			poolWorkers := []*apiWorker{newApiWorker(), newApiWorker(), newApiWorker()}
+			for _, w := range poolWorkers {
+				pool.Add(w)
+			}
+
+			for i := 0; i < 6; i++ {
+				Expect(pool.Next()).To(Equal(poolWorkers[i%3]))
+			}
+		})
+	})
+
+	When("using the least-in-flight strategy", func() {
+		It("should avoid workers that are busy with requests", func() {
+			busyStream := &mockWorkerStream{
+				responses: make(chan *apispb.ClientMessage, 10),
+			}
+			busyStream.respond.Store(true)
+			busy, _ := startBroker(busyStream)
+			idle := newApiWorker()
+
+			pool := workers.NewWorkerPool[*apispb.ServerMessage, *apispb.ClientMessage](workers.LeastInFlight)
+			pool.Add(busy)
+			pool.Add(idle)
+
+			By("leaving a request to the busy worker unanswered")
+			busyStream.respond.Store(false)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				_, _ = busy.Send(ctx, &apispb.ServerMessage{Id: "pending-request"})
+			}()
+			Eventually(busy.InFlight).Should(BeEquivalentTo(1))
+
+			for i := 0; i < 4; i++ {
+				Expect(pool.Next()).To(Equal(idle))
+			}
+		})
+	})
+})
@@ -15,11 +15,15 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/nitrictech/nitric/core/pkg/help"
 	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	"google.golang.org/grpc"
 )
 
@@ -31,6 +35,30 @@ type GrpcBidiStreamServer[ServerMessage IdentifiableMessage, ClientMessage Ident
 
 type RequestIdentifier = string
 
+// inFlightRequests counts the requests awaiting a response across all workers
+var inFlightRequests atomic.Int64
+
+// InFlightRequests returns the number of requests awaiting a response across all workers
+func InFlightRequests() int64 {
+	return inFlightRequests.Load()
+}
+
+// WaitForInFlightRequests blocks until no requests are awaiting a response from workers, or ctx is done
+func WaitForInFlightRequests(ctx context.Context) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for InFlightRequests() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
 // IdentifiableMessage is a message that has an ID, used to match requests and responses
 type IdentifiableMessage interface {
 	GetId() RequestIdentifier
@@ -41,52 +69,88 @@ type IdentifiableMessage interface {
 //	the broker facilitates sending requests to a worker and awaits responses, then matches them with the corresponding request.
 //	This enables users of this brokers to treat the request/response lifecycle with the worker as if they were synchronous.
 type WorkerRequestBroker[Request IdentifiableMessage, Response IdentifiableMessage] struct {
+	id                     string
 	workerConnectionStream GrpcBidiStreamServer[Request, Response]
 	responseChannelLock    sync.RWMutex
 	responseChannels       map[RequestIdentifier]chan Response
-	running                bool
+	running                atomic.Bool
+	inFlight               atomic.Int64
 }
 
-func (w *WorkerRequestBroker[Request, Response]) Send(req Request) (*Response, error) {
-	if !w.running {
+// Send a request to the worker and wait for its response.
+// Send returns a *WorkerTimeoutError if ctx reaches its deadline before the worker responds, or ctx.Err() if ctx is cancelled.
+func (w *WorkerRequestBroker[Request, Response]) Send(ctx context.Context, req Request) (*Response, error) {
+	if !w.running.Load() {
 		return nil, fmt.Errorf("worker server not running, call Start() before sending requests")
 	}
 
+	w.responseChannelLock.Lock()
 	if _, exists := w.responseChannels[req.GetId()]; exists {
+		w.responseChannelLock.Unlock()
 		return nil, fmt.Errorf("request with ID %s already exists", req.GetId())
 	}
-
-	w.responseChannelLock.Lock()
-	responseChannel := make(chan Response)
+	// buffered, so a late response never blocks the receive loop after the request has been abandoned
+	responseChannel := make(chan Response, 1)
 	w.responseChannels[req.GetId()] = responseChannel
 	w.responseChannelLock.Unlock()
 
+	w.inFlight.Add(1)
+	inFlightRequests.Add(1)
+
+	// clean up the map reference
+	defer func() {
+		w.inFlight.Add(-1)
+		inFlightRequests.Add(-1)
+		w.responseChannelLock.Lock()
+		delete(w.responseChannels, req.GetId())
+		w.responseChannelLock.Unlock()
+	}()
+
 	if err := w.workerConnectionStream.Send(req); err != nil {
 		return nil, err
 	}
 
 	// wait for the response
-	response, ok := <-responseChannel
-	if !ok {
-		return nil, fmt.Errorf("error receiving response")
+	select {
+	case response, ok := <-responseChannel:
+		if !ok {
+			return nil, fmt.Errorf("error receiving response, the worker connection was closed")
+		}
+
+		return &response, nil
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			logger.WarnContext(ctx, "worker did not respond before the deadline", "worker_id", w.id)
+			return nil, &WorkerTimeoutError{RequestId: req.GetId()}
+		}
+
+		return nil, ctx.Err()
 	}
+}
 
-	// clean up the map reference
-	w.responseChannelLock.Lock()
-	delete(w.responseChannels, req.GetId())
-	w.responseChannelLock.Unlock()
+// Id uniquely identifies the worker connection
+func (w *WorkerRequestBroker[Request, Response]) Id() string {
+	return w.id
+}
 
-	return &response, nil
+// InFlight returns the number of requests sent to the worker that are still awaiting a response
+func (w *WorkerRequestBroker[Request, Response]) InFlight() int64 {
+	return w.inFlight.Load()
 }
 
 // Run the connection broker, allowing async communication with the worker (i.e.
 func (w *WorkerRequestBroker[Request, Response]) Run() error {
-	if w.running {
+	if w.running.Swap(true) {
 		return fmt.Errorf("worker already running")
 	}
+	// Unblock any requests still waiting on a response once the worker is gone
+	defer w.closeResponseChannels()
+
+	metrics.TrackWorkerInFlight(w.id, w.InFlight)
+	defer metrics.UntrackWorkerInFlight(w.id)
+
 	// Read responses on the client connection stream and match them with the corresponding response channel.
 	for {
-		w.running = true
 		response, err := w.workerConnectionStream.Recv()
 		if err != nil {
 			// Most likely the client closed the connection
@@ -97,20 +161,32 @@ func (w *WorkerRequestBroker[Request, Response]) Run() error {
 		responseChannel, ok := w.responseChannels[response.GetId()]
 		w.responseChannelLock.RUnlock()
 		if !ok {
-			// This would indicate a critical bug, it means that the client (SDK) did not return a response with an ID that matches a request that was sent to it
-			// OR there may have been a network error that resulted in duplicate responses
-			logger.Errorf("nitric received a response for an unknown request, response could not be returned: %s", help.BugInNitricHelpText())
+			// Either the request was abandoned after its timeout elapsed, which is expected when workers are slow,
+			// or the client (SDK) returned a response with an ID that doesn't match any request sent to it
+			logger.With("worker_id", w.id, "request_id", response.GetId()).Warn("nitric received a response for an unknown or expired request, response could not be returned")
+			continue
 		}
 
 		responseChannel <- response
 	}
 }
 
+func (w *WorkerRequestBroker[Request, Response]) closeResponseChannels() {
+	w.responseChannelLock.Lock()
+	defer w.responseChannelLock.Unlock()
+
+	w.running.Store(false)
+	for id, responseChannel := range w.responseChannels {
+		close(responseChannel)
+		delete(w.responseChannels, id)
+	}
+}
+
 func NewWorkerRequestBroker[Request IdentifiableMessage, Response IdentifiableMessage](workerConnectionStream GrpcBidiStreamServer[Request, Response]) *WorkerRequestBroker[Request, Response] {
 	return &WorkerRequestBroker[Request, Response]{
+		id:                     GenerateUniqueId(),
 		workerConnectionStream: workerConnectionStream,
 		responseChannelLock:    sync.RWMutex{},
 		responseChannels:       make(map[string]chan Response),
-		running:                false,
 	}
 }
@@ -0,0 +1,168 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// mockWorkerStream simulates a worker, optionally echoing a response for each request it receives
+type mockWorkerStream struct {
+	grpc.ServerStream
+	respond   atomic.Bool
+	responses chan *apispb.ClientMessage
+}
+
+func (m *mockWorkerStream) Send(req *apispb.ServerMessage) error {
+	if m.respond.Load() {
+		m.responses <- &apispb.ClientMessage{Id: req.Id}
+	}
+	return nil
+}
+
+func (m *mockWorkerStream) Recv() (*apispb.ClientMessage, error) {
+	resp, ok := <-m.responses
+	if !ok {
+		return nil, io.EOF
+	}
+	return resp, nil
+}
+
+func startBroker(stream *mockWorkerStream) (*workers.WorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage], chan error) {
+	broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- broker.Run()
+	}()
+
+	// wait for the broker to start accepting requests
+	Eventually(func() error {
+		_, err := broker.Send(context.Background(), &apispb.ServerMessage{Id: workers.GenerateUniqueId()})
+		return err
+	}).Should(Succeed())
+
+	return broker, done
+}
+
+var _ = Describe("WorkerRequestBroker", func() {
+	var stream *mockWorkerStream
+
+	BeforeEach(func() {
+		stream = &mockWorkerStream{
+			responses: make(chan *apispb.ClientMessage, 10),
+		}
+		stream.respond.Store(true)
+	})
+
+	When("the worker responds", func() {
+		It("should return the matching response", func() {
+			broker, _ := startBroker(stream)
+
+			resp, err := broker.Send(context.Background(), &apispb.ServerMessage{Id: "test-request"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect((*resp).Id).To(Equal("test-request"))
+		})
+	})
+
+	When("the worker does not respond before the deadline", func() {
+		It("should return a WorkerTimeoutError", func() {
+			broker, _ := startBroker(stream)
+			stream.respond.Store(false)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := broker.Send(ctx, &apispb.ServerMessage{Id: "slow-request"})
+			Expect(workers.IsWorkerTimeout(err)).To(BeTrue())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+			By("allowing the request ID to be reused once it has timed out")
+			stream.respond.Store(true)
+			_, err = broker.Send(context.Background(), &apispb.ServerMessage{Id: "slow-request"})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	When("the request is cancelled", func() {
+		It("should return the context error", func() {
+			broker, _ := startBroker(stream)
+			stream.respond.Store(false)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := broker.Send(ctx, &apispb.ServerMessage{Id: "cancelled-request"})
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(workers.IsWorkerTimeout(err)).To(BeFalse())
+		})
+	})
+
+	When("the worker disconnects while a request is pending", func() {
+		It("should return an error instead of blocking", func() {
+			broker, done := startBroker(stream)
+			stream.respond.Store(false)
+
+			result := make(chan error, 1)
+			go func() {
+				_, err := broker.Send(context.Background(), &apispb.ServerMessage{Id: "orphaned-request"})
+				result <- err
+			}()
+
+			// give the request time to be sent before the worker goes away
+			time.Sleep(10 * time.Millisecond)
+			close(stream.responses)
+
+			Eventually(done).Should(Receive(MatchError(io.EOF)))
+			Eventually(result).Should(Receive(HaveOccurred()))
+		})
+	})
+
+	When("waiting for in flight requests", func() {
+		It("should wait until pending requests complete", func() {
+			broker, _ := startBroker(stream)
+			stream.respond.Store(false)
+
+			result := make(chan error, 1)
+			go func() {
+				_, err := broker.Send(context.Background(), &apispb.ServerMessage{Id: "draining-request"})
+				result <- err
+			}()
+
+			Eventually(workers.InFlightRequests).Should(BeNumerically(">", 0))
+
+			By("timing out while the request is pending")
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			Expect(workers.WaitForInFlightRequests(ctx)).To(MatchError(context.DeadlineExceeded))
+
+			By("returning once the worker responds")
+			stream.responses <- &apispb.ClientMessage{Id: "draining-request"}
+			Eventually(result).Should(Receive(BeNil()))
+			Expect(workers.WaitForInFlightRequests(context.Background())).To(Succeed())
+		})
+	})
+})
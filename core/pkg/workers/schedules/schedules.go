@@ -15,9 +15,14 @@
 package schedules
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"sync"
 
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
 	"github.com/nitrictech/nitric/core/pkg/workers"
 )
@@ -26,18 +31,24 @@ type ScheduleName = string
 
 type WorkerConnection = workers.WorkerRequestBroker[*schedulespb.ServerMessage, *schedulespb.ClientMessage]
 
+type WorkerPool = workers.WorkerPool[*schedulespb.ServerMessage, *schedulespb.ClientMessage]
+
 type ScheduleRequestHandler interface {
 	schedulespb.SchedulesServer
-	HandleRequest(request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error)
 	WorkerCount() int
 }
 
 type ScheduleWorkerManager struct {
-	workerMap map[ScheduleName]*WorkerConnection
+	workerMap map[ScheduleName]*WorkerPool
+	strategy  workers.LoadBalancingStrategy
 	mutex     sync.RWMutex
 }
 
-var _ schedulespb.SchedulesServer = &ScheduleWorkerManager{}
+var (
+	_ schedulespb.SchedulesServer = &ScheduleWorkerManager{}
+	_ workers.Introspectable      = &ScheduleWorkerManager{}
+)
 
 func (s *ScheduleWorkerManager) registerSchedule(scheduleWorker *WorkerConnection, request *schedulespb.RegistrationRequest) error {
 	s.mutex.Lock()
@@ -45,11 +56,12 @@ func (s *ScheduleWorkerManager) registerSchedule(scheduleWorker *WorkerConnectio
 
 	scheduleName := request.GetScheduleName()
 
-	if _, exists := s.workerMap[scheduleName]; exists {
-		return fmt.Errorf("schedule already registered with name: %s", scheduleName)
+	// workers registering the same schedule share its triggers
+	if _, exists := s.workerMap[scheduleName]; !exists {
+		s.workerMap[scheduleName] = workers.NewWorkerPool[*schedulespb.ServerMessage, *schedulespb.ClientMessage](s.strategy)
 	}
 
-	s.workerMap[scheduleName] = scheduleWorker
+	s.workerMap[scheduleName].Add(scheduleWorker)
 
 	return nil
 }
@@ -58,16 +70,14 @@ func (s *ScheduleWorkerManager) unregisterSchedule(scheduleWorker *WorkerConnect
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	var resultKey string
-
-	for k, wrb := range s.workerMap {
-		if wrb == scheduleWorker {
-			resultKey = k
+	for k, pool := range s.workerMap {
+		if pool.Contains(scheduleWorker) {
+			if pool.Remove(scheduleWorker) == 0 {
+				delete(s.workerMap, k)
+			}
 			break
 		}
 	}
-
-	delete(s.workerMap, resultKey)
 }
 
 func (s *ScheduleWorkerManager) Schedule(stream schedulespb.Schedules_ScheduleServer) error {
@@ -100,7 +110,7 @@ func (s *ScheduleWorkerManager) Schedule(stream schedulespb.Schedules_ScheduleSe
 	return worker.Run()
 }
 
-func (s *ScheduleWorkerManager) HandleRequest(request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
+func (s *ScheduleWorkerManager) HandleRequest(ctx context.Context, request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
@@ -108,24 +118,68 @@ func (s *ScheduleWorkerManager) HandleRequest(request *schedulespb.ServerMessage
 		request.Id = workers.GenerateUniqueId()
 	}
 
-	worker, ok := s.workerMap[request.GetIntervalRequest().GetScheduleName()]
+	ctx = logger.WithRequestId(ctx, request.Id)
+
+	pool, ok := s.workerMap[request.GetIntervalRequest().GetScheduleName()]
 
 	if !ok {
 		return nil, fmt.Errorf("no worker registered for schedule: %s", request.GetIntervalRequest().GetScheduleName())
 	}
 
-	resp, err := worker.Send(request)
+	worker := pool.Next()
+	if worker == nil {
+		return nil, fmt.Errorf("no worker available for schedule: %s", request.GetIntervalRequest().GetScheduleName())
+	}
+
+	ctx, cancel := workers.WithWorkerTimeout(ctx)
+	defer cancel()
+
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Schedule)
+	resp, err := worker.Send(ctx, request)
+	timer.ObserveDuration(err)
+	if err != nil {
+		return nil, err
+	}
 
-	return *resp, err
+	return *resp, nil
 }
 
 func (s *ScheduleWorkerManager) WorkerCount() int {
-	return len(s.workerMap)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	total := 0
+	for _, pool := range s.workerMap {
+		total += pool.Len()
+	}
+
+	return total
+}
+
+// RegisteredWorkers describes the workers registered for each schedule
+func (s *ScheduleWorkerManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	registered := make([]*introspectionpb.RegisteredWorker, 0, len(s.workerMap))
+	for scheduleName, pool := range s.workerMap {
+		registered = append(registered, &introspectionpb.RegisteredWorker{
+			Type:        introspectionpb.WorkerType_Schedule,
+			Name:        scheduleName,
+			Trigger:     scheduleName,
+			WorkerCount: int32(pool.Len()),
+		})
+	}
+
+	slices.SortFunc(registered, workers.CompareRegisteredWorkers)
+
+	return registered
 }
 
 func New() *ScheduleWorkerManager {
 	return &ScheduleWorkerManager{
-		workerMap: make(map[string]*WorkerConnection),
+		workerMap: make(map[string]*WorkerPool),
+		strategy:  workers.LoadBalancingStrategyFromEnv(),
 		mutex:     sync.RWMutex{},
 	}
 }
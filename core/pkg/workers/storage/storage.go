@@ -15,11 +15,17 @@
 package storage
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/nitrictech/nitric/core/pkg/help"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	"github.com/nitrictech/nitric/core/pkg/workers"
 )
@@ -35,7 +41,7 @@ type BucketListenerManager struct {
 
 type BucketRequestHandler interface {
 	storagepb.StorageListenerServer
-	HandleRequest(request *storagepb.ServerMessage) (*storagepb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *storagepb.ServerMessage) (*storagepb.ClientMessage, error)
 	WorkerCount() int
 }
 
@@ -48,6 +54,70 @@ type BucketEventListener struct {
 	bucketName     BucketName
 	eventType      storagepb.BlobEventType
 	keyPrefixMatch string
+	keySuffixMatch string
+	keyGlobMatch   string
+}
+
+// globMetaChars are the characters with special meaning in a path.Match pattern
+const globMetaChars = "*?[\\"
+
+// matches returns true if the key satisfies all of the listener's key filters
+func (l *BucketEventListener) matches(key string) bool {
+	if !strings.HasPrefix(key, l.keyPrefixMatch) || !strings.HasSuffix(key, l.keySuffixMatch) {
+		return false
+	}
+
+	if l.keyGlobMatch == "" {
+		return true
+	}
+
+	// the pattern is validated on registration, so an error here can only mean no match
+	matched, _ := path.Match(l.keyGlobMatch, key)
+
+	return matched
+}
+
+// literalPrefix returns the longest literal prefix every matching key must start with
+func (l *BucketEventListener) literalPrefix() string {
+	globPrefix := l.keyGlobMatch
+	if i := strings.IndexAny(globPrefix, globMetaChars); i >= 0 {
+		globPrefix = globPrefix[:i]
+	}
+
+	if len(globPrefix) > len(l.keyPrefixMatch) {
+		return globPrefix
+	}
+
+	return l.keyPrefixMatch
+}
+
+// literalSuffix returns the longest literal suffix every matching key must end with
+func (l *BucketEventListener) literalSuffix() string {
+	globSuffix := l.keyGlobMatch
+	if i := strings.LastIndexAny(globSuffix, globMetaChars); i >= 0 {
+		globSuffix = globSuffix[i+1:]
+	}
+
+	if len(globSuffix) > len(l.keySuffixMatch) {
+		return globSuffix
+	}
+
+	return l.keySuffixMatch
+}
+
+// overlaps returns true if a key could satisfy the filters of both listeners
+func (l *BucketEventListener) overlaps(other *BucketEventListener) bool {
+	return MutualPrefixCheck(l.literalPrefix(), other.literalPrefix()) && MutualSuffixCheck(l.literalSuffix(), other.literalSuffix())
+}
+
+// trigger describes the key filters of the listener
+func (l *BucketEventListener) trigger() string {
+	trigger := fmt.Sprintf("%s %s*%s", l.eventType.String(), l.keyPrefixMatch, l.keySuffixMatch)
+	if l.keyGlobMatch != "" {
+		trigger = fmt.Sprintf("%s matching %s", trigger, l.keyGlobMatch)
+	}
+
+	return trigger
 }
 
 // WorkerCount returns the total number of workers across all listeners
@@ -60,7 +130,29 @@ func (b *BucketListenerManager) WorkerCount() int {
 	return total
 }
 
-// findMatchingListener or error if not found, for specific bucket, event type, and key prefix
+// RegisteredWorkers describes each listener by bucket, event type and key filters
+func (b *BucketListenerManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	registered := []*introspectionpb.RegisteredWorker{}
+	for bucketName, listeners := range b.listenerMap {
+		for _, listener := range listeners {
+			registered = append(registered, &introspectionpb.RegisteredWorker{
+				Type:        introspectionpb.WorkerType_BucketListener,
+				Name:        bucketName,
+				Trigger:     listener.trigger(),
+				WorkerCount: 1,
+			})
+		}
+	}
+
+	slices.SortFunc(registered, workers.CompareRegisteredWorkers)
+
+	return registered
+}
+
+// findMatchingListener or error if not found, for specific bucket, event type, and key filters
 func (b *BucketListenerManager) findMatchingListener(bucketName BucketName, eventType storagepb.BlobEventType, key string) (*BucketEventListener, error) {
 	b.mutex.RLock()
 	defer b.mutex.RUnlock()
@@ -77,13 +169,13 @@ func (b *BucketListenerManager) findMatchingListener(bucketName BucketName, even
 			continue
 		}
 
-		if strings.HasPrefix(key, listener.keyPrefixMatch) {
+		if listener.matches(key) {
 			matchedListener = listener
 		}
 	}
 
 	if matchedListener == nil {
-		return nil, fmt.Errorf("no listener registered for bucket %s and eventType %s with prefix matcher that matches blob key %s", bucketName, eventType, key)
+		return nil, fmt.Errorf("no listener registered for bucket %s and eventType %s with key filters that match blob key %s", bucketName, eventType, key)
 	}
 
 	return matchedListener, nil
@@ -94,7 +186,15 @@ func MutualPrefixCheck(prefix, other string) bool {
 	return strings.HasPrefix(prefix, other) || strings.HasPrefix(other, prefix)
 }
 
-var _ storagepb.StorageListenerServer = &BucketListenerManager{}
+// MutualSuffixCheck returns true if either string ends with the other
+func MutualSuffixCheck(suffix, other string) bool {
+	return strings.HasSuffix(suffix, other) || strings.HasSuffix(other, suffix)
+}
+
+var (
+	_ storagepb.StorageListenerServer = &BucketListenerManager{}
+	_ workers.Introspectable          = &BucketListenerManager{}
+)
 
 // RegisterNewListener adds a new listener for a given registration request
 func (b *BucketListenerManager) RegisterNewListener(registration *storagepb.RegistrationRequest, stream storagepb.StorageListener_ListenServer) (*WorkerConnection, error) {
@@ -105,28 +205,36 @@ func (b *BucketListenerManager) RegisterNewListener(registration *storagepb.Regi
 		prefixFilter = ""
 	}
 
+	globFilter := registration.GetKeyGlobFilter()
+	if _, err := path.Match(globFilter, ""); err != nil {
+		return nil, fmt.Errorf("invalid listener key glob filter %s for bucket '%s': %w", globFilter, bucketName, err)
+	}
+
+	newListener := &BucketEventListener{
+		bucketName:     bucketName,
+		eventType:      eventType,
+		keyPrefixMatch: prefixFilter,
+		keySuffixMatch: registration.GetKeySuffixFilter(),
+		keyGlobMatch:   globFilter,
+	}
+
 	workerConn := workers.NewWorkerRequestBroker[*storagepb.ServerMessage, *storagepb.ClientMessage](stream)
 
 	if b.listenerMap[bucketName] == nil {
 		b.listenerMap[bucketName] = make([]*BucketEventListener, 0, 1)
 	} else {
-		// Prevent overlapping prefixes for the same bucket and event type
+		// Prevent overlapping key filters for the same bucket and event type
 		for _, existingListener := range b.listenerMap[bucketName] {
 			if existingListener.eventType != eventType {
 				continue
 			}
-			if MutualPrefixCheck(existingListener.keyPrefixMatch, prefixFilter) {
-				return nil, fmt.Errorf("overlapping listener key prefixes %s and %s for bucket '%s'", existingListener.keyPrefixMatch, prefixFilter, bucketName)
+			if existingListener.overlaps(newListener) {
+				return nil, fmt.Errorf("overlapping listener key filters %s and %s for bucket '%s'", existingListener.trigger(), newListener.trigger(), bucketName)
 			}
 		}
 	}
 
-	newListener := &BucketEventListener{
-		connection:     workerConn,
-		bucketName:     bucketName,
-		eventType:      eventType,
-		keyPrefixMatch: prefixFilter,
-	}
+	newListener.connection = workerConn
 	b.listenerMap[bucketName] = append(b.listenerMap[bucketName], newListener)
 
 	return workerConn, nil
@@ -163,7 +271,7 @@ func (b *BucketListenerManager) Listen(stream storagepb.StorageListener_ListenSe
 }
 
 // HandleRequest processes incoming requests and directs them to the appropriate listener
-func (b *BucketListenerManager) HandleRequest(request *storagepb.ServerMessage) (*storagepb.ClientMessage, error) {
+func (b *BucketListenerManager) HandleRequest(ctx context.Context, request *storagepb.ServerMessage) (*storagepb.ClientMessage, error) {
 	b.mutex.RLock()
 	defer b.mutex.RUnlock()
 
@@ -171,6 +279,8 @@ func (b *BucketListenerManager) HandleRequest(request *storagepb.ServerMessage)
 		request.Id = workers.GenerateUniqueId()
 	}
 
+	ctx = logger.WithRequestId(ctx, request.Id)
+
 	blobEventRequest := request.GetBlobEventRequest()
 	if blobEventRequest == nil {
 		return nil, fmt.Errorf("received unhandled request message type %T", request.Content)
@@ -185,9 +295,17 @@ func (b *BucketListenerManager) HandleRequest(request *storagepb.ServerMessage)
 		return nil, err
 	}
 
-	response, err := listener.connection.Send(request)
+	ctx, cancel := workers.WithWorkerTimeout(ctx)
+	defer cancel()
+
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_BucketListener)
+	response, err := listener.connection.Send(ctx, request)
+	timer.ObserveDuration(err)
+	if err != nil {
+		return nil, err
+	}
 
-	return *response, err
+	return *response, nil
 }
 
 func New() *BucketListenerManager {
@@ -0,0 +1,119 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+func register(manager *BucketListenerManager, registration *storagepb.RegistrationRequest) error {
+	if registration.BucketName == "" {
+		registration.BucketName = "test-bucket"
+	}
+
+	_, err := manager.RegisterNewListener(registration, nil)
+
+	return err
+}
+
+var _ = Describe("BucketListenerManager", func() {
+	var manager *BucketListenerManager
+
+	BeforeEach(func() {
+		manager = New()
+	})
+
+	When("registering listeners", func() {
+		It("should reject overlapping prefixes for the same event type", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/thumbs/"})).ToNot(Succeed())
+		})
+
+		It("should allow overlapping prefixes for different event types", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{
+				KeyPrefixFilter: "images/",
+				BlobEventType:   storagepb.BlobEventType_MetadataUpdated,
+			})).To(Succeed())
+		})
+
+		It("should allow overlapping prefixes with distinct suffixes", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/", KeySuffixFilter: ".png"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/", KeySuffixFilter: ".jpg"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeySuffixFilter: "large.png"})).ToNot(Succeed())
+		})
+
+		It("should use the literal parts of glob filters to detect overlaps", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyGlobFilter: "logs/*.txt"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyGlobFilter: "logs/*.json"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "logs/2024/", KeySuffixFilter: ".txt"})).ToNot(Succeed())
+		})
+
+		It("should reject malformed glob filters", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyGlobFilter: "logs/[a-"})).ToNot(Succeed())
+		})
+	})
+
+	When("finding a listener for an event", func() {
+		BeforeEach(func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/", KeySuffixFilter: ".png"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyGlobFilter: "logs/*/app-?.log"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{BlobEventType: storagepb.BlobEventType_Restored})).To(Succeed())
+		})
+
+		It("should match keys satisfying the prefix and suffix filters", func() {
+			listener, err := manager.findMatchingListener("test-bucket", storagepb.BlobEventType_Created, "images/cat.png")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listener.keySuffixMatch).To(Equal(".png"))
+
+			_, err = manager.findMatchingListener("test-bucket", storagepb.BlobEventType_Created, "images/cat.jpg")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should match keys satisfying the glob filter", func() {
+			listener, err := manager.findMatchingListener("test-bucket", storagepb.BlobEventType_Created, "logs/2024/app-1.log")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listener.keyGlobMatch).To(Equal("logs/*/app-?.log"))
+
+			_, err = manager.findMatchingListener("test-bucket", storagepb.BlobEventType_Created, "logs/2024/01/app-1.log")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should only match listeners for the event type", func() {
+			_, err := manager.findMatchingListener("test-bucket", storagepb.BlobEventType_Restored, "anything")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.findMatchingListener("test-bucket", storagepb.BlobEventType_MetadataUpdated, "images/cat.png")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("describing registered workers", func() {
+		It("should include the key filters in the trigger", func() {
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyPrefixFilter: "images/", KeySuffixFilter: ".png"})).To(Succeed())
+			Expect(register(manager, &storagepb.RegistrationRequest{KeyGlobFilter: "logs/*.txt"})).To(Succeed())
+
+			triggers := []string{}
+			for _, worker := range manager.RegisteredWorkers() {
+				triggers = append(triggers, worker.Trigger)
+			}
+
+			Expect(triggers).To(ConsistOf("Created images/*.png", "Created * matching logs/*.txt"))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+const defaultWorkerTimeout = 10 * time.Second
+
+// WorkerTimeoutError is returned when a worker fails to respond to a request before its deadline
+type WorkerTimeoutError struct {
+	RequestId RequestIdentifier
+}
+
+func (e *WorkerTimeoutError) Error() string {
+	return fmt.Sprintf("worker did not respond to request %s before the deadline", e.RequestId)
+}
+
+// Unwrap allows errors.Is(err, context.DeadlineExceeded) to match worker timeouts
+func (e *WorkerTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// IsWorkerTimeout returns true if err, or any error it wraps, is a WorkerTimeoutError
+func IsWorkerTimeout(err error) bool {
+	var timeoutErr *WorkerTimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// WorkerTimeout returns the default time to wait for a worker to respond, configured in seconds by WORKER_TIMEOUT.
+// A timeout of 0 disables the default, leaving requests bound only by the deadline of their context.
+func WorkerTimeout() time.Duration {
+	seconds, err := env.WORKER_TIMEOUT.Int()
+	if err != nil || seconds < 0 {
+		logger.Warnf("invalid WORKER_TIMEOUT %q, defaulting to %s", env.WORKER_TIMEOUT.String(), defaultWorkerTimeout)
+		return defaultWorkerTimeout
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// WithWorkerTimeout returns a copy of ctx bound by the default WorkerTimeout, unless ctx already has a deadline.
+func WithWorkerTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		return context.WithCancel(ctx)
+	}
+
+	timeout := WorkerTimeout()
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
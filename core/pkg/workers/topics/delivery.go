@@ -0,0 +1,142 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// DeliveryMode determines how messages are delivered to the subscribers of a topic
+type DeliveryMode string
+
+const (
+	// DeliverAll delivers each message to every subscriber once, failing the delivery if any subscriber fails.
+	// The message is redelivered by the cloud provider, skipping subscribers that handled a message with the same idempotency key.
+	DeliverAll DeliveryMode = "all"
+	// RetryFailed retries only the subscribers that failed, failing the delivery once the retries are exhausted
+	RetryFailed DeliveryMode = "retry-failed"
+)
+
+const (
+	defaultDeliveryRetries = 3
+	// time to wait before the first retry, doubled for each retry after it
+	retryBackoff = 100 * time.Millisecond
+	// how long successful deliveries are remembered for each idempotency key
+	deliveryRecordTTL = time.Hour
+)
+
+// DeliveryModeFromEnv returns the mode configured by TOPIC_DELIVERY_MODE, defaulting to DeliverAll
+func DeliveryModeFromEnv() DeliveryMode {
+	mode := DeliveryMode(strings.ToLower(env.TOPIC_DELIVERY_MODE.String()))
+
+	switch mode {
+	case DeliverAll, RetryFailed:
+		return mode
+	default:
+		logger.Warnf("unknown TOPIC_DELIVERY_MODE %q, defaulting to %s", mode, DeliverAll)
+		return DeliverAll
+	}
+}
+
+// DeliveryRetriesFromEnv returns the number of retries configured by TOPIC_DELIVERY_RETRIES
+func DeliveryRetriesFromEnv() int {
+	retries, err := env.TOPIC_DELIVERY_RETRIES.Int()
+	if err != nil || retries < 0 {
+		logger.Warnf("invalid TOPIC_DELIVERY_RETRIES %q, defaulting to %d", env.TOPIC_DELIVERY_RETRIES.String(), defaultDeliveryRetries)
+		return defaultDeliveryRetries
+	}
+
+	return retries
+}
+
+// deliveryRecord tracks the subscribers that successfully handled a message
+type deliveryRecord struct {
+	subscribers map[string]bool
+	expires     time.Time
+}
+
+// deliveryTracker remembers which subscribers handled messages with an idempotency key,
+// so redelivered messages are only sent to subscribers that haven't handled them.
+type deliveryTracker struct {
+	records   map[string]*deliveryRecord
+	ttl       time.Duration
+	lastSweep time.Time
+	now       func() time.Time
+	lock      sync.Mutex
+}
+
+func deliveryKey(topicName string, idempotencyKey string) string {
+	return topicName + "/" + idempotencyKey
+}
+
+// delivered returns true if the subscriber has handled the message with the given key
+func (d *deliveryTracker) delivered(key string, subscriberId string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	record, ok := d.records[key]
+	if !ok || d.now().After(record.expires) {
+		return false
+	}
+
+	return record.subscribers[subscriberId]
+}
+
+// record a successful delivery of the message with the given key to a subscriber
+func (d *deliveryTracker) record(key string, subscriberId string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := d.now()
+	d.sweep(now)
+
+	record, ok := d.records[key]
+	if !ok || now.After(record.expires) {
+		record = &deliveryRecord{subscribers: map[string]bool{}}
+		d.records[key] = record
+	}
+
+	record.subscribers[subscriberId] = true
+	record.expires = now.Add(d.ttl)
+}
+
+// sweep removes expired records, at most once per minute
+func (d *deliveryTracker) sweep(now time.Time) {
+	if now.Sub(d.lastSweep) < time.Minute {
+		return
+	}
+
+	for key, record := range d.records {
+		if now.After(record.expires) {
+			delete(d.records, key)
+		}
+	}
+
+	d.lastSweep = now
+}
+
+func newDeliveryTracker(ttl time.Duration) *deliveryTracker {
+	return &deliveryTracker{
+		records:   map[string]*deliveryRecord{},
+		ttl:       ttl,
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
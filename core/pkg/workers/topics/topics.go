@@ -16,14 +16,19 @@ package topics
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/nitrictech/nitric/core/pkg/help"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	"github.com/nitrictech/nitric/core/pkg/workers"
-	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 type TopicName = string
@@ -32,13 +37,19 @@ type WorkerConnection = workers.WorkerRequestBroker[*topicspb.ServerMessage, *to
 
 type SubscriptionRequestHandler interface {
 	topicspb.SubscriberServer
-	HandleRequest(request *topicspb.ServerMessage) (*topicspb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *topicspb.ServerMessage) (*topicspb.ClientMessage, error)
 	WorkerCount() int
 }
 
 type SubscriberManager struct {
 	subscriberMap map[TopicName][]*WorkerConnection
+	mode          DeliveryMode
+	retries       int
+	deliveries    *deliveryTracker
 	lock          sync.RWMutex
+
+	// the attributes each subscriber requires messages to have, subscribers without a filter receive all messages
+	filters map[*WorkerConnection]map[string]string
 }
 
 func (s *SubscriberManager) registerSubscriber(subscriber *WorkerConnection, registrationRequest *topicspb.RegistrationRequest) error {
@@ -53,6 +64,10 @@ func (s *SubscriberManager) registerSubscriber(subscriber *WorkerConnection, reg
 
 	s.subscriberMap[topicName] = append(s.subscriberMap[topicName], subscriber)
 
+	if len(registrationRequest.GetAttributeFilter()) > 0 {
+		s.filters[subscriber] = registrationRequest.GetAttributeFilter()
+	}
+
 	return nil
 }
 
@@ -63,6 +78,7 @@ func (s *SubscriberManager) unregisterSubscriber(topicName string, subscriber *W
 	s.subscriberMap[topicName] = slices.DeleteFunc[[]*WorkerConnection](s.subscriberMap[topicName], func(wc *WorkerConnection) bool {
 		return wc == subscriber
 	})
+	delete(s.filters, subscriber)
 
 	if len(s.subscriberMap[topicName]) == 0 {
 		delete(s.subscriberMap, topicName)
@@ -81,6 +97,26 @@ func (s *SubscriberManager) WorkerCount() int {
 	return count
 }
 
+// RegisteredWorkers describes the subscribers registered for each topic
+func (s *SubscriberManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	registered := make([]*introspectionpb.RegisteredWorker, 0, len(s.subscriberMap))
+	for topicName, subscribers := range s.subscriberMap {
+		registered = append(registered, &introspectionpb.RegisteredWorker{
+			Type:        introspectionpb.WorkerType_Subscription,
+			Name:        topicName,
+			Trigger:     topicName,
+			WorkerCount: int32(len(subscribers)),
+		})
+	}
+
+	slices.SortFunc(registered, workers.CompareRegisteredWorkers)
+
+	return registered
+}
+
 // Subscribe allows the local nitric server to register new subscribers
 //
 //	called by Nitric applications wishing to subscribe to a topic.
@@ -122,8 +158,20 @@ func (s *SubscriberManager) Subscribe(subscriberConnectionStream topicspb.Subscr
 	return nil
 }
 
-// findMatchingSubscriber returns the subscribers for a given topic, or an error if none are found
-func (s *SubscriberManager) findMatchingSubscriber(topicName string) ([]*WorkerConnection, error) {
+// matchesFilter returns true if the attributes contain every attribute in the filter
+func matchesFilter(attributes map[string]string, filter map[string]string) bool {
+	for key, value := range filter {
+		if attribute, ok := attributes[key]; !ok || attribute != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findMatchingSubscriber returns the subscribers for a given topic whose attribute filter matches the message attributes,
+// or an error if none are registered for the topic
+func (s *SubscriberManager) findMatchingSubscriber(topicName string, attributes map[string]string) ([]*WorkerConnection, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 	workers, ok := s.subscriberMap[topicName]
@@ -131,53 +179,159 @@ func (s *SubscriberManager) findMatchingSubscriber(topicName string) ([]*WorkerC
 		return nil, fmt.Errorf("no workers registered for topic subscription: %s", topicName)
 	}
 
-	return workers, nil
+	// copied, since unregistering a subscriber modifies the registered slice in place
+	return slices.DeleteFunc(slices.Clone(workers), func(subscriber *WorkerConnection) bool {
+		return !matchesFilter(attributes, s.filters[subscriber])
+	}), nil
+}
+
+// DeliveryResult is the outcome of delivering a message to a single subscriber
+type DeliveryResult struct {
+	Subscriber *WorkerConnection
+	// Success is true if the subscriber handled the message successfully
+	Success bool
+	// Err is set if the message couldn't be delivered, e.g. the subscriber didn't respond in time
+	Err error
 }
 
 // ForwardRequestToSubscribers forwards an event to all subscribers for a given topic
-// returns a slice of errors encountered while forwarding the event
-func ForwardRequestToSubscribers(subscribers []*WorkerConnection, request *topicspb.ServerMessage) (bool, error) {
-	success := true
-	errs, _ := errgroup.WithContext(context.Background())
-
-	for _, subscriber := range subscribers {
-		footLongSub := subscriber
-		errs.Go(func() error {
-			resp, err := footLongSub.Send(request)
+// returns the result of delivering the event to each subscriber, in the same order as subscribers
+func ForwardRequestToSubscribers(ctx context.Context, subscribers []*WorkerConnection, request *topicspb.ServerMessage) []*DeliveryResult {
+	results := make([]*DeliveryResult, len(subscribers))
+	wg := sync.WaitGroup{}
+
+	for i, subscriber := range subscribers {
+		wg.Add(1)
+		go func(i int, subscriber *WorkerConnection) {
+			defer wg.Done()
+
+			result := &DeliveryResult{Subscriber: subscriber}
+
+			resp, err := subscriber.Send(ctx, request)
 			if err != nil {
-				return err
-			} else if !(*resp).GetMessageResponse().GetSuccess() {
-				success = false
+				result.Err = err
+			} else {
+				result.Success = (*resp).GetMessageResponse().GetSuccess()
 			}
-			return nil
-		})
+
+			results[i] = result
+		}(i, subscriber)
 	}
 
-	err := errs.Wait()
-	if err != nil {
-		return false, fmt.Errorf("errors occurred handling subscription %v", errs)
+	wg.Wait()
+
+	return results
+}
+
+// deliver a message to subscribers, recording successful deliveries of messages with an idempotency key
+func (s *SubscriberManager) deliver(ctx context.Context, subscribers []*WorkerConnection, request *topicspb.ServerMessage) []*DeliveryResult {
+	ctx, cancel := workers.WithWorkerTimeout(ctx)
+	defer cancel()
+
+	results := ForwardRequestToSubscribers(ctx, subscribers, request)
+
+	messageRequest := request.GetMessageRequest()
+	if idempotencyKey := messageRequest.GetMessage().GetIdempotencyKey(); idempotencyKey != "" {
+		key := deliveryKey(messageRequest.GetTopicName(), idempotencyKey)
+
+		for _, result := range results {
+			if result.Success {
+				s.deliveries.record(key, result.Subscriber.Id())
+			}
+		}
 	}
 
-	return success, nil
+	return results
+}
+
+// waitForRetry waits before retrying delivery, returning false if ctx is done first
+func waitForRetry(ctx context.Context, retry int) bool {
+	timer := time.NewTimer(retryBackoff << (retry - 1))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
-func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
+func (s *SubscriberManager) HandleRequest(ctx context.Context, request *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
 	if request.Id == "" {
 		request.Id = workers.GenerateUniqueId()
 	}
 
+	ctx = logger.WithRequestId(ctx, request.Id)
+
 	messageRequest := request.GetMessageRequest()
 	if messageRequest == nil {
 		return nil, fmt.Errorf("invalid request, expected message request. %s", help.BugInNitricHelpText())
 	}
 	topicName := messageRequest.GetTopicName()
 
-	subscribers, err := s.findMatchingSubscriber(topicName)
+	subscribers, err := s.findMatchingSubscriber(topicName, messageRequest.GetAttributes())
 	if err != nil {
 		return nil, err
 	}
 
-	success, err := ForwardRequestToSubscribers(subscribers, request)
+	if len(subscribers) == 0 {
+		logger.DebugContext(ctx, "message attributes didn't match the filter of any subscriber", "topic", topicName)
+	}
+
+	// skip subscribers that already handled a previous delivery of this message
+	if idempotencyKey := messageRequest.GetMessage().GetIdempotencyKey(); idempotencyKey != "" {
+		key := deliveryKey(topicName, idempotencyKey)
+		subscribers = slices.DeleteFunc(subscribers, func(subscriber *WorkerConnection) bool {
+			return s.deliveries.delivered(key, subscriber.Id())
+		})
+	}
+
+	attempts := 1
+	if s.mode == RetryFailed {
+		attempts += s.retries
+	}
+
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_Subscription)
+
+	var failed []*DeliveryResult
+	for attempt := 0; attempt < attempts && len(subscribers) > 0; attempt++ {
+		if attempt > 0 {
+			if !waitForRetry(ctx, attempt) {
+				break
+			}
+
+			logger.DebugContext(ctx, "retrying delivery to failed subscribers", "topic", topicName, "subscribers", len(subscribers), "attempt", attempt)
+
+			// each attempt is a new request, so late responses to the previous attempt aren't mistaken for its response
+			request = proto.Clone(request).(*topicspb.ServerMessage)
+			request.Id = workers.GenerateUniqueId()
+		}
+
+		failed = slices.DeleteFunc(s.deliver(ctx, subscribers, request), func(result *DeliveryResult) bool {
+			return result.Success
+		})
+
+		subscribers = make([]*WorkerConnection, 0, len(failed))
+		for _, result := range failed {
+			subscribers = append(subscribers, result.Subscriber)
+		}
+	}
+
+	success := len(failed) == 0
+
+	errs := []error{}
+	for _, result := range failed {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+
+	if len(errs) > 0 {
+		err = fmt.Errorf("errors occurred handling subscription: %w", errors.Join(errs...))
+	}
+
+	timer.ObserveDuration(err)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +342,8 @@ func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*top
 	// instead we return a response indicating success or failure of all subscribers.
 	//
 	// Since subscription workers should always be idempotent, this ensures a failure in any subscriber
-	// will trigger a retry of the event processing.
+	// will trigger a retry of the event processing. Messages with an idempotency key are only redelivered
+	// to subscribers that haven't handled them.
 	return &topicspb.ClientMessage{
 		Content: &topicspb.ClientMessage_MessageResponse{
 			MessageResponse: &topicspb.MessageResponse{
@@ -201,6 +356,10 @@ func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*top
 func New() *SubscriberManager {
 	return &SubscriberManager{
 		subscriberMap: make(map[string][]*WorkerConnection),
+		filters:       make(map[*WorkerConnection]map[string]string),
+		mode:          DeliveryModeFromEnv(),
+		retries:       DeliveryRetriesFromEnv(),
+		deliveries:    newDeliveryTracker(deliveryRecordTTL),
 		lock:          sync.RWMutex{},
 	}
 }
@@ -0,0 +1,266 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// fakeSubscriber responds to the nth message it receives with the result of handle, or not at all if respond is false
+type fakeSubscriber struct {
+	grpc.ServerStream
+	handle    func(n int) (respond bool, success bool)
+	received  atomic.Int32
+	responses chan *topicspb.ClientMessage
+}
+
+func (f *fakeSubscriber) Send(req *topicspb.ServerMessage) error {
+	if req.GetMessageRequest() == nil {
+		f.responses <- &topicspb.ClientMessage{Id: req.Id}
+		return nil
+	}
+
+	respond, success := f.handle(int(f.received.Add(1)))
+	if respond {
+		f.responses <- &topicspb.ClientMessage{
+			Id: req.Id,
+			Content: &topicspb.ClientMessage_MessageResponse{
+				MessageResponse: &topicspb.MessageResponse{Success: success},
+			},
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeSubscriber) Recv() (*topicspb.ClientMessage, error) {
+	resp, ok := <-f.responses
+	if !ok {
+		return nil, io.EOF
+	}
+	return resp, nil
+}
+
+func succeeds(int) (bool, bool) { return true, true }
+func fails(int) (bool, bool)    { return true, false }
+
+// subscribers started by the current test, closed once it completes
+var subscribers []*fakeSubscriber
+
+func subscribe(manager *SubscriberManager, topicName string, handle func(n int) (bool, bool)) *fakeSubscriber {
+	return subscribeWithFilter(manager, topicName, nil, handle)
+}
+
+func subscribeWithFilter(manager *SubscriberManager, topicName string, filter map[string]string, handle func(n int) (bool, bool)) *fakeSubscriber {
+	stream := &fakeSubscriber{
+		handle:    handle,
+		responses: make(chan *topicspb.ClientMessage, 10),
+	}
+
+	broker := workers.NewWorkerRequestBroker[*topicspb.ServerMessage, *topicspb.ClientMessage](stream)
+	Expect(manager.registerSubscriber(broker, &topicspb.RegistrationRequest{TopicName: topicName, AttributeFilter: filter})).To(Succeed())
+
+	go func() {
+		_ = broker.Run()
+	}()
+
+	// wait for the broker to start accepting requests
+	Eventually(func() error {
+		_, err := broker.Send(context.Background(), &topicspb.ServerMessage{Id: workers.GenerateUniqueId()})
+		return err
+	}).Should(Succeed())
+
+	subscribers = append(subscribers, stream)
+
+	return stream
+}
+
+func publish(manager *SubscriberManager, ctx context.Context, idempotencyKey string) (*topicspb.ClientMessage, error) {
+	return publishRequest(manager, ctx, &topicspb.MessageRequest{
+		TopicName: "test-topic",
+		Message: &topicspb.TopicMessage{
+			IdempotencyKey: idempotencyKey,
+		},
+	})
+}
+
+func publishRequest(manager *SubscriberManager, ctx context.Context, request *topicspb.MessageRequest) (*topicspb.ClientMessage, error) {
+	return manager.HandleRequest(ctx, &topicspb.ServerMessage{
+		Content: &topicspb.ServerMessage_MessageRequest{
+			MessageRequest: request,
+		},
+	})
+}
+
+var _ = Describe("SubscriberManager", func() {
+	var manager *SubscriberManager
+
+	BeforeEach(func() {
+		manager = New()
+		manager.mode = DeliverAll
+		manager.retries = 2
+	})
+
+	AfterEach(func() {
+		for _, subscriber := range subscribers {
+			close(subscriber.responses)
+		}
+		subscribers = nil
+	})
+
+	When("delivering to all subscribers", func() {
+		It("should fail the delivery if any subscriber fails", func() {
+			ok := subscribe(manager, "test-topic", succeeds)
+			failing := subscribe(manager, "test-topic", fails)
+
+			resp, err := publish(manager, context.Background(), "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeFalse())
+
+			Expect(ok.received.Load()).To(Equal(int32(1)))
+			Expect(failing.received.Load()).To(Equal(int32(1)))
+		})
+
+		It("should only redeliver messages with an idempotency key to subscribers that haven't handled them", func() {
+			ok := subscribe(manager, "test-topic", succeeds)
+			flaky := subscribe(manager, "test-topic", func(n int) (bool, bool) { return true, n > 1 })
+
+			resp, err := publish(manager, context.Background(), "message-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeFalse())
+
+			By("redelivering the message")
+			resp, err = publish(manager, context.Background(), "message-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(ok.received.Load()).To(Equal(int32(1)))
+			Expect(flaky.received.Load()).To(Equal(int32(2)))
+
+			By("delivering a message with a different key to every subscriber")
+			_, err = publish(manager, context.Background(), "message-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(ok.received.Load()).To(Equal(int32(2)))
+			Expect(flaky.received.Load()).To(Equal(int32(3)))
+		})
+
+		It("should return an error if a subscriber doesn't respond in time", func() {
+			subscribe(manager, "test-topic", succeeds)
+			subscribe(manager, "test-topic", func(int) (bool, bool) { return false, false })
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			_, err := publish(manager, ctx, "")
+			Expect(workers.IsWorkerTimeout(err)).To(BeTrue())
+		})
+	})
+
+	When("subscribers filter on attributes", func() {
+		It("should only deliver messages with matching attributes", func() {
+			all := subscribe(manager, "test-topic", succeeds)
+			filtered := subscribeWithFilter(manager, "test-topic", map[string]string{"region": "eu"}, succeeds)
+
+			_, err := publishRequest(manager, context.Background(), &topicspb.MessageRequest{
+				TopicName:  "test-topic",
+				Message:    &topicspb.TopicMessage{},
+				Attributes: map[string]string{"region": "us"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(all.received.Load()).To(Equal(int32(1)))
+			Expect(filtered.received.Load()).To(Equal(int32(0)))
+
+			_, err = publishRequest(manager, context.Background(), &topicspb.MessageRequest{
+				TopicName:  "test-topic",
+				Message:    &topicspb.TopicMessage{},
+				Attributes: map[string]string{"region": "eu", "priority": "high"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(all.received.Load()).To(Equal(int32(2)))
+			Expect(filtered.received.Load()).To(Equal(int32(1)))
+		})
+
+		It("should succeed if no subscriber matches", func() {
+			filtered := subscribeWithFilter(manager, "test-topic", map[string]string{"region": "eu"}, fails)
+
+			resp, err := publish(manager, context.Background(), "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(filtered.received.Load()).To(Equal(int32(0)))
+		})
+	})
+
+	When("retrying failed subscribers", func() {
+		BeforeEach(func() {
+			manager.mode = RetryFailed
+		})
+
+		It("should only retry the subscribers that failed", func() {
+			ok := subscribe(manager, "test-topic", succeeds)
+			flaky := subscribe(manager, "test-topic", func(n int) (bool, bool) { return true, n > 1 })
+
+			resp, err := publish(manager, context.Background(), "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(ok.received.Load()).To(Equal(int32(1)))
+			Expect(flaky.received.Load()).To(Equal(int32(2)))
+		})
+
+		It("should fail the delivery once the retries are exhausted", func() {
+			failing := subscribe(manager, "test-topic", fails)
+
+			resp, err := publish(manager, context.Background(), "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeFalse())
+
+			Expect(failing.received.Load()).To(Equal(int32(3)))
+		})
+	})
+})
+
+var _ = Describe("deliveryTracker", func() {
+	It("should forget deliveries once they expire", func() {
+		now := time.Now()
+		tracker := newDeliveryTracker(time.Minute)
+		tracker.now = func() time.Time { return now }
+
+		tracker.record("topic/key", "subscriber")
+		Expect(tracker.delivered("topic/key", "subscriber")).To(BeTrue())
+		Expect(tracker.delivered("topic/key", "other-subscriber")).To(BeFalse())
+		Expect(tracker.delivered("topic/other-key", "subscriber")).To(BeFalse())
+
+		now = now.Add(2 * time.Minute)
+		Expect(tracker.delivered("topic/key", "subscriber")).To(BeFalse())
+
+		By("sweeping expired deliveries when recording new ones")
+		tracker.record("topic/new-key", "subscriber")
+		Expect(tracker.records).NotTo(HaveKey("topic/key"))
+	})
+})
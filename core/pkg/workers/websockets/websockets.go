@@ -15,11 +15,16 @@
 package websockets
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/nitrictech/nitric/core/pkg/help"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	introspectionpb "github.com/nitrictech/nitric/core/pkg/proto/introspection/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
 	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
@@ -27,15 +32,26 @@ import (
 // WorkerConnection manages communication between websocket and worker
 type WorkerConnection = workers.WorkerRequestBroker[*websocketspb.ServerMessage, *websocketspb.ClientMessage]
 
+// WorkerPool balances events between workers handling the same socket and event type
+type WorkerPool = workers.WorkerPool[*websocketspb.ServerMessage, *websocketspb.ClientMessage]
+
 type WebsocketRequestHandler interface {
 	websocketspb.WebsocketHandlerServer
-	HandleRequest(request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error)
 	WorkerCount() int
 }
 
+// eventHandlers holds the workers handling one event type of a socket
+type eventHandlers struct {
+	socketName string
+	eventType  websocketspb.WebsocketEventType
+	pool       *WorkerPool
+}
+
 // WebsocketManager manages connections and event handlers for websockets
 type WebsocketManager struct {
-	handlers map[string]*WorkerConnection
+	handlers map[string]*eventHandlers
+	strategy workers.LoadBalancingStrategy
 	mutex    sync.RWMutex
 }
 
@@ -46,7 +62,35 @@ func generateHandlerKey(socketName string, eventType websocketspb.WebsocketEvent
 
 // WorkerCount returns the total number of websocket handlers
 func (wm *WebsocketManager) WorkerCount() int {
-	return len(wm.handlers)
+	wm.mutex.RLock()
+	defer wm.mutex.RUnlock()
+
+	total := 0
+	for _, h := range wm.handlers {
+		total += h.pool.Len()
+	}
+
+	return total
+}
+
+// RegisteredWorkers describes the handlers registered for each socket and event type
+func (wm *WebsocketManager) RegisteredWorkers() []*introspectionpb.RegisteredWorker {
+	wm.mutex.RLock()
+	defer wm.mutex.RUnlock()
+
+	registered := make([]*introspectionpb.RegisteredWorker, 0, len(wm.handlers))
+	for _, h := range wm.handlers {
+		registered = append(registered, &introspectionpb.RegisteredWorker{
+			Type:        introspectionpb.WorkerType_WebsocketHandler,
+			Name:        h.socketName,
+			Trigger:     h.eventType.String(),
+			WorkerCount: int32(h.pool.Len()),
+		})
+	}
+
+	slices.SortFunc(registered, workers.CompareRegisteredWorkers)
+
+	return registered
 }
 
 // registerHandler adds a new handler to the manager
@@ -59,11 +103,16 @@ func (wm *WebsocketManager) registerHandler(handler *WorkerConnection, registrat
 
 	handlerKey := generateHandlerKey(socketName, eventType)
 
-	if _, exists := wm.handlers[handlerKey]; exists {
-		return fmt.Errorf("websocket handler already registered, socket: %s eventType: %s", socketName, eventType.String())
+	// handlers registering the same socket and event type share its events
+	if _, exists := wm.handlers[handlerKey]; !exists {
+		wm.handlers[handlerKey] = &eventHandlers{
+			socketName: socketName,
+			eventType:  eventType,
+			pool:       workers.NewWorkerPool[*websocketspb.ServerMessage, *websocketspb.ClientMessage](wm.strategy),
+		}
 	}
 
-	wm.handlers[handlerKey] = handler
+	wm.handlers[handlerKey].pool.Add(handler)
 	return nil
 }
 
@@ -71,16 +120,14 @@ func (wm *WebsocketManager) unregisterHandler(handler *WorkerConnection) {
 	wm.mutex.Lock()
 	defer wm.mutex.Unlock()
 
-	var resultKey string
-
-	for k, wrb := range wm.handlers {
-		if wrb == handler {
-			resultKey = k
+	for k, h := range wm.handlers {
+		if h.pool.Contains(handler) {
+			if h.pool.Remove(handler) == 0 {
+				delete(wm.handlers, k)
+			}
 			break
 		}
 	}
-
-	delete(wm.handlers, resultKey)
 }
 
 // ManageEventHandlers handles the registration of new websocket event handlers
@@ -114,23 +161,28 @@ func (wm *WebsocketManager) HandleEvents(stream websocketspb.WebsocketHandler_Ha
 	return handler.Run()
 }
 
-// FindMatchingHandler returns a handler for a specific socket and event type, or an error if not found
+// FindMatchingHandler returns the next handler for a specific socket and event type, or an error if not found
 func (wm *WebsocketManager) FindMatchingHandler(socketName string, eventType websocketspb.WebsocketEventType) (*WorkerConnection, error) {
 	wm.mutex.RLock()
 	defer wm.mutex.RUnlock()
 
 	handlerKey := generateHandlerKey(socketName, eventType)
 
-	handler, exists := wm.handlers[handlerKey]
+	h, exists := wm.handlers[handlerKey]
 	if !exists {
 		return nil, fmt.Errorf("no handlers for socket: %s and eventType: %s", socketName, eventType.String())
 	}
 
+	handler := h.pool.Next()
+	if handler == nil {
+		return nil, fmt.Errorf("no handlers available for socket: %s and eventType: %s", socketName, eventType.String())
+	}
+
 	return handler, nil
 }
 
 // HandleRequest handles incoming requests and forwards them to the appropriate handler
-func (wm *WebsocketManager) HandleRequest(request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error) {
+func (wm *WebsocketManager) HandleRequest(ctx context.Context, request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error) {
 	eventRequest := request.GetWebsocketEventRequest()
 	if eventRequest == nil {
 		return nil, fmt.Errorf("invalid request, expected a websocket event request. %s", help.BugInNitricHelpText())
@@ -140,6 +192,8 @@ func (wm *WebsocketManager) HandleRequest(request *websocketspb.ServerMessage) (
 		request.Id = workers.GenerateUniqueId()
 	}
 
+	ctx = logger.WithRequestId(ctx, request.Id)
+
 	socketName := eventRequest.SocketName
 	eventType := determineEventType(eventRequest)
 
@@ -148,7 +202,12 @@ func (wm *WebsocketManager) HandleRequest(request *websocketspb.ServerMessage) (
 		return nil, err
 	}
 
-	response, err := handler.Send(request)
+	ctx, cancel := workers.WithWorkerTimeout(ctx)
+	defer cancel()
+
+	timer := metrics.NewWorkerRequestTimer(introspectionpb.WorkerType_WebsocketHandler)
+	response, err := handler.Send(ctx, request)
+	timer.ObserveDuration(err)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +228,8 @@ func determineEventType(eventRequest *websocketspb.WebsocketEventRequest) websoc
 // NewWebsocketManager creates a new instance of WebsocketManager
 func NewWebsocketManager() *WebsocketManager {
 	return &WebsocketManager{
-		handlers: make(map[string]*WorkerConnection),
+		handlers: make(map[string]*eventHandlers),
+		strategy: workers.LoadBalancingStrategyFromEnv(),
 		mutex:    sync.RWMutex{},
 	}
 }